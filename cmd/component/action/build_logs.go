@@ -0,0 +1,147 @@
+package action
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"bunnyshell.com/cli/pkg/api/component"
+	"bunnyshell.com/cli/pkg/api/pipeline"
+	componentPkg "bunnyshell.com/cli/pkg/component"
+	"bunnyshell.com/cli/pkg/config"
+	"bunnyshell.com/cli/pkg/formatter"
+	"bunnyshell.com/cli/pkg/lib"
+	"bunnyshell.com/cli/pkg/progress"
+	"bunnyshell.com/sdk"
+	"github.com/spf13/cobra"
+)
+
+// pipelines building images have a stage named after the build
+const buildStageName = "build"
+
+var errNoPipeline = errors.New("no build pipeline found for the component environment")
+
+type BuildLogs struct {
+	Pipeline *sdk.PipelineItem `json:"pipeline" yaml:"pipeline"`
+
+	Images componentPkg.ComponentImages `json:"images,omitempty" yaml:"images,omitempty"`
+}
+
+func (buildLogs *BuildLogs) Tabulate(w *tabwriter.Writer) {
+	formatter.WriteStylish(w, buildLogs.Pipeline)
+
+	if buildLogs.Images == nil {
+		return
+	}
+
+	// flush the pipeline so the images align on their own
+	w.Flush()
+
+	fmt.Fprintln(w)
+	buildLogs.Images.Tabulate(w)
+}
+
+func init() {
+	options := config.GetOptions()
+	settings := config.GetSettings()
+
+	follow := false
+	showImage := false
+
+	command := &cobra.Command{
+		Use: "build-logs",
+
+		Short: "Show the latest build pipeline of the component environment",
+		Long: "Show the latest build pipeline of the component environment, with a link to the full job logs.\n" +
+			"Pipelines belong to the whole environment, the latest one having a build stage is shown.\n" +
+			"With --follow, the pipeline stages are followed until it finishes.\n" +
+			"With --show-image, the images running in the component pods are shown, which are the built ones only once deployed.",
+
+		ValidArgsFunction: cobra.NoFileCompletions,
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			model, err := component.Get(component.NewItemOptions(settings.Profile.Context.ServiceComponent))
+			if err != nil {
+				return lib.FormatCommandError(cmd, err)
+			}
+
+			pipelineItem, err := getLatestBuildPipeline(model.GetEnvironment())
+			if err != nil {
+				return lib.FormatCommandError(cmd, err)
+			}
+
+			if follow {
+				if err = progress.Pipeline(pipelineItem.GetId(), nil); err != nil {
+					return err
+				}
+
+				pipelineItem, err = pipeline.Get(pipeline.NewItemOptions(pipelineItem.GetId()))
+				if err != nil {
+					return lib.FormatCommandError(cmd, err)
+				}
+			}
+
+			buildLogs := &BuildLogs{Pipeline: pipelineItem}
+
+			if showImage {
+				if buildLogs.Images, err = componentPkg.GetComponentImages(model.GetId()); err != nil {
+					return lib.FormatCommandError(cmd, err)
+				}
+			}
+
+			return lib.FormatCommandData(cmd, buildLogs)
+		},
+	}
+
+	flags := command.Flags()
+
+	flags.AddFlag(options.ServiceComponent.GetRequiredFlag("id"))
+
+	flags.BoolVarP(&follow, "follow", "f", follow, "Follow the pipeline until it finishes")
+	flags.BoolVar(&showImage, "show-image", showImage, "Show the image reference and digest running in the component pods")
+
+	mainCmd.AddCommand(command)
+}
+
+// getLatestBuildPipeline goes through the pipelines as listed by the API, newest first, for the first one with a build stage.
+func getLatestBuildPipeline(environmentID string) (*sdk.PipelineItem, error) {
+	listOptions := pipeline.NewListOptions()
+	listOptions.Environment = environmentID
+
+	for {
+		collection, err := pipeline.List(listOptions)
+		if err != nil {
+			return nil, err
+		}
+
+		if collection.HasEmbedded() {
+			for _, item := range collection.Embedded.GetItem() {
+				pipelineItem, err := pipeline.Get(pipeline.NewItemOptions(item.GetId()))
+				if err != nil {
+					return nil, err
+				}
+
+				if hasBuildStage(pipelineItem) {
+					return pipelineItem, nil
+				}
+			}
+		}
+
+		if !collection.HasLinks() || !collection.Links.HasNext() {
+			return nil, errNoPipeline
+		}
+
+		listOptions.Page++
+	}
+}
+
+func hasBuildStage(pipelineItem *sdk.PipelineItem) bool {
+	for _, stage := range pipelineItem.GetStages() {
+		if strings.Contains(strings.ToLower(stage.GetName()), buildStageName) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,44 @@
+package action
+
+import (
+	"bunnyshell.com/cli/pkg/api/component"
+	"bunnyshell.com/cli/pkg/config"
+	"bunnyshell.com/cli/pkg/lib"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	options := config.GetOptions()
+	settings := config.GetSettings()
+
+	connectionOptions := component.NewConnectionOptions("")
+
+	command := &cobra.Command{
+		Use:     "connection",
+		Aliases: []string{"conn"},
+
+		Short: "Show the connection string for a component",
+		Long:  "Show the connection string for a component, built from its endpoints and variables. Credentials are masked unless --show-secrets is provided.",
+
+		ValidArgsFunction: cobra.NoFileCompletions,
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			connectionOptions.ID = settings.Profile.Context.ServiceComponent
+
+			connection, err := component.Connection(connectionOptions)
+			if err != nil {
+				return lib.FormatCommandError(cmd, err)
+			}
+
+			return lib.FormatCommandData(cmd, connection)
+		},
+	}
+
+	flags := command.Flags()
+
+	flags.AddFlag(options.ServiceComponent.GetRequiredFlag("id"))
+
+	connectionOptions.UpdateFlagSet(flags)
+
+	mainCmd.AddCommand(command)
+}
@@ -0,0 +1,95 @@
+package action
+
+import (
+	"bunnyshell.com/cli/pkg/api/component"
+	"bunnyshell.com/cli/pkg/api/environment"
+	"bunnyshell.com/cli/pkg/config"
+	k8sCp "bunnyshell.com/cli/pkg/k8s/kubectl/cp"
+	k8sWizard "bunnyshell.com/cli/pkg/wizard/k8s"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	options := config.GetOptions()
+	settings := config.GetSettings()
+
+	execOptions := ExecOptions{}
+	noPreserve := false
+
+	command := &cobra.Command{
+		Use: "cp SRC DEST",
+
+		Short: "Copy files and directories to and from a component container",
+		Long:  "Copy files and directories to and from a component container.\nThe container side is prefixed with \":\", the other side is a local path.\nThe pod is resolved from the selected Deployment, StatefulSet, DaemonSet, Job or CronJob resource.\nRequires the tar binary in the container image.",
+
+		Example: "cp --id ComponentID ./dump.sql :/tmp/dump.sql\ncp --id ComponentID --resource namespace/deployment/name :/var/log/app ./logs",
+
+		Args: cobra.ExactArgs(2),
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			componentItem, err := component.Get(component.NewItemOptions(settings.Profile.Context.ServiceComponent))
+			if err != nil {
+				return err
+			}
+
+			kubeConfig, err := environment.KubeConfig(environment.NewKubeConfigOptions(componentItem.GetEnvironment()))
+			if err != nil {
+				return err
+			}
+
+			if err = ensureExecPodSelected(&execOptions, componentItem.GetId(), kubeConfig.Bytes); err != nil {
+				return err
+			}
+
+			copyCommand, err := k8sCp.Copy(&k8sCp.Options{
+				Namespace: execOptions.namespace,
+				PodName:   execOptions.PodName,
+
+				Source:      args[0],
+				Destination: args[1],
+
+				NoPreserve: noPreserve,
+
+				KubeConfig: kubeConfig.Bytes,
+			})
+			if err != nil {
+				return err
+			}
+
+			if execOptions.Container == "" {
+				container, err := k8sWizard.ContainerSelect(&k8sWizard.ContainerListOptions{
+					Namespace: execOptions.namespace,
+					PodName:   execOptions.PodName,
+
+					Client: copyCommand.Clientset.CoreV1(),
+				})
+				if err != nil {
+					return err
+				}
+
+				execOptions.Container = container.Name
+			}
+
+			copyCommand.Container = execOptions.Container
+
+			if err = copyCommand.Validate(); err != nil {
+				return err
+			}
+
+			return copyCommand.Run()
+		},
+	}
+
+	flags := command.Flags()
+
+	flags.AddFlag(options.ServiceComponent.GetRequiredFlag("id"))
+
+	flags.StringVarP(&execOptions.ResourcePath, "resource", "s", execOptions.ResourcePath, "The cluster resource to copy from or into (namespace/kind/name format)")
+	flags.StringVar(&execOptions.PodName, "pod", execOptions.PodName, "Pod name in namespace/pod-name format")
+	flags.StringVar(&execOptions.Container, "container", execOptions.Container, "Container name")
+	command.MarkFlagsMutuallyExclusive("resource", "pod")
+
+	flags.BoolVar(&noPreserve, "no-preserve", noPreserve, "Do not preserve ownership and permissions in the container")
+
+	mainCmd.AddCommand(command)
+}
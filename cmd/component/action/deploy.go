@@ -0,0 +1,60 @@
+package action
+
+import (
+	"bunnyshell.com/cli/cmd/environment/action"
+	"bunnyshell.com/cli/pkg/api/component"
+	"bunnyshell.com/cli/pkg/api/environment"
+	"bunnyshell.com/cli/pkg/api/k8s"
+	"bunnyshell.com/cli/pkg/config"
+	"bunnyshell.com/cli/pkg/lib"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	settings := config.GetSettings()
+	options := config.GetOptions()
+
+	deployOptions := environment.NewDeployOptions("")
+	k8sIntegration := ""
+
+	command := &cobra.Command{
+		Use: "deploy",
+
+		Short: "Deploy a single component, leaving the rest of the environment as it is",
+
+		ValidArgsFunction: cobra.NoFileCompletions,
+
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return action.ValidateActionOptions(&deployOptions.ActionOptions)
+		},
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			model, err := component.Get(component.NewItemOptions(settings.Profile.Context.ServiceComponent))
+			if err != nil {
+				return lib.FormatCommandError(cmd, err)
+			}
+
+			if settings.IsStylish() {
+				cmd.Printf("Deploying component %s (%s)...\n\n", model.GetName(), model.GetId())
+			}
+
+			deployOptions.ID = model.GetEnvironment()
+			deployOptions.SetComponents(model.GetName())
+
+			return action.HandleDeploy(cmd, deployOptions, "", k8sIntegration, settings.IsStylish())
+		},
+	}
+
+	flags := command.Flags()
+
+	flags.AddFlag(options.ServiceComponent.GetRequiredFlag("id"))
+
+	deployOptions.ActionOptions.UpdateFlagSet(flags)
+	flags.StringVar(&deployOptions.IncludedDepdendencies, "included-dependencies", deployOptions.IncludedDepdendencies, "Include dependencies in the deployment (none, all, missing)")
+
+	flags.StringVar(&k8sIntegration, "k8s", k8sIntegration, "Set Kubernetes integration for the environment (if not set)")
+
+	k8s.RegisterFlagCompletion(command, "k8s")
+
+	mainCmd.AddCommand(command)
+}
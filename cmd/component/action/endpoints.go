@@ -0,0 +1,59 @@
+package action
+
+import (
+	"errors"
+
+	"bunnyshell.com/cli/pkg/api/component/endpoint"
+	"bunnyshell.com/cli/pkg/config"
+	"bunnyshell.com/cli/pkg/lib"
+	"bunnyshell.com/cli/pkg/util"
+	"github.com/spf13/cobra"
+)
+
+var errNoComponentEndpoints = errors.New("component has no public endpoints")
+
+func init() {
+	options := config.GetOptions()
+	settings := config.GetSettings()
+
+	open := false
+
+	command := &cobra.Command{
+		Use:     "endpoints",
+		Aliases: []string{"end"},
+
+		Short: "List the public endpoints of a component",
+
+		ValidArgsFunction: cobra.NoFileCompletions,
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			model, err := endpoint.Get(endpoint.NewItemOptions(settings.Profile.Context.ServiceComponent))
+			if err != nil {
+				return lib.FormatCommandError(cmd, err)
+			}
+
+			if !open {
+				return lib.FormatCommandData(cmd, model)
+			}
+
+			endpoints := model.GetEndpoints()
+			if len(endpoints) == 0 {
+				return errNoComponentEndpoints
+			}
+
+			if settings.IsStylish() {
+				cmd.Printf("Opening %s\n", endpoints[0])
+			}
+
+			return util.OpenURL(endpoints[0])
+		},
+	}
+
+	flags := command.Flags()
+
+	flags.AddFlag(options.ServiceComponent.GetRequiredFlag("id"))
+
+	flags.BoolVar(&open, "open", open, "Open the first endpoint in the default browser")
+
+	mainCmd.AddCommand(command)
+}
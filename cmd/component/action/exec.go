@@ -0,0 +1,177 @@
+package action
+
+import (
+	"fmt"
+	"strings"
+
+	"bunnyshell.com/cli/pkg/api/component"
+	"bunnyshell.com/cli/pkg/api/environment"
+	"bunnyshell.com/cli/pkg/config"
+	"bunnyshell.com/cli/pkg/k8s"
+	"bunnyshell.com/cli/pkg/k8s/bridge"
+	k8sExec "bunnyshell.com/cli/pkg/k8s/kubectl/exec"
+	k8sWizard "bunnyshell.com/cli/pkg/wizard/k8s"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+type ExecOptions struct {
+	ResourcePath string
+	PodName      string
+	Container    string
+
+	NoTTY bool
+
+	namespace string
+}
+
+func (o *ExecOptions) UpdateFlagSet(flags *pflag.FlagSet) {
+	flags.StringVarP(&o.ResourcePath, "resource", "s", o.ResourcePath, "The cluster resource to exec into (namespace/kind/name format)")
+	flags.StringVar(&o.PodName, "pod", o.PodName, "Pod name in namespace/pod-name format")
+	flags.StringVar(&o.Container, "container", o.Container, "Container name")
+
+	flags.BoolVar(&o.NoTTY, "no-tty", o.NoTTY, "Do not allocate a TTY, even when attached to a terminal")
+}
+
+func init() {
+	options := config.GetOptions()
+	settings := config.GetSettings()
+
+	execOptions := ExecOptions{}
+
+	command := &cobra.Command{
+		Use: "exec -- command [args...]",
+
+		Short: "Execute a command in a component container",
+		Long:  "Execute a command in a component container.\nThe pod is resolved from the selected Deployment, StatefulSet, DaemonSet, Job or CronJob resource.\nA TTY is allocated when attached to a terminal.",
+
+		Example: "exec --id ComponentID --resource namespace/cronjob/name -- ls -la",
+
+		Args: cobra.MinimumNArgs(1),
+
+		ValidArgsFunction: cobra.NoFileCompletions,
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runExec(settings.Profile.Context.ServiceComponent, &execOptions, args)
+		},
+	}
+
+	flags := command.Flags()
+
+	flags.AddFlag(options.ServiceComponent.GetRequiredFlag("id"))
+
+	execOptions.UpdateFlagSet(flags)
+	command.MarkFlagsMutuallyExclusive("resource", "pod")
+
+	mainCmd.AddCommand(command)
+}
+
+func runExec(componentID string, execOptions *ExecOptions, command []string) error {
+	componentItem, err := component.Get(component.NewItemOptions(componentID))
+	if err != nil {
+		return err
+	}
+
+	kubeConfig, err := environment.KubeConfig(environment.NewKubeConfigOptions(componentItem.GetEnvironment()))
+	if err != nil {
+		return err
+	}
+
+	execCommand, err := k8sExec.Exec(&k8sExec.Options{
+		TTY:     !execOptions.NoTTY,
+		Stdin:   true,
+		Command: command,
+
+		KubeConfig: kubeConfig.Bytes,
+	})
+	if err != nil {
+		return err
+	}
+
+	if err = ensureExecPodSelected(execOptions, componentItem.GetId(), kubeConfig.Bytes); err != nil {
+		return err
+	}
+
+	if execOptions.Container == "" {
+		container, err := k8sWizard.ContainerSelect(&k8sWizard.ContainerListOptions{
+			Namespace: execOptions.namespace,
+			PodName:   execOptions.PodName,
+
+			Client: execCommand.PodClient,
+		})
+		if err != nil {
+			return err
+		}
+
+		execOptions.Container = container.Name
+	}
+
+	execCommand.Namespace = execOptions.namespace
+	execCommand.PodName = execOptions.PodName
+	execCommand.ContainerName = execOptions.Container
+
+	if err = execCommand.Validate(); err != nil {
+		return err
+	}
+
+	return execCommand.Run()
+}
+
+func ensureExecPodSelected(execOptions *ExecOptions, componentID string, kubeConfig []byte) error {
+	if execOptions.PodName != "" {
+		parts := strings.Split(execOptions.PodName, "/")
+
+		if len(parts) != 2 {
+			return errInvalidPodName
+		}
+
+		execOptions.namespace = parts[0]
+		execOptions.PodName = parts[1]
+
+		return nil
+	}
+
+	resourceSpec, err := getExecResourceSpec(execOptions.ResourcePath, componentID)
+	if err != nil {
+		return err
+	}
+
+	client, err := k8s.NewKubernetesClientFromBytes(kubeConfig)
+	if err != nil {
+		return err
+	}
+
+	pod, err := client.ResolveRunningPod(resourceSpec.Namespace, resourceSpec.Kind, resourceSpec.Name)
+	if err != nil {
+		return err
+	}
+
+	execOptions.namespace = pod.Namespace
+	execOptions.PodName = pod.Name
+
+	return nil
+}
+
+func getExecResourceSpec(resourcePath string, componentID string) (*bridge.ResourceSpec, error) {
+	if resourcePath != "" {
+		resourceSpec := bridge.NewResourceSpec(strings.ToLower(resourcePath))
+		if resourceSpec == nil {
+			return nil, fmt.Errorf("%w: %s", bridge.ErrInvalidResourceSpec, resourcePath)
+		}
+
+		return resourceSpec, nil
+	}
+
+	resource, err := k8sWizard.ExecSelect(&k8sWizard.ExecListOptions{
+		Component: componentID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &bridge.ResourceSpec{
+		Namespace: resource.GetNamespace(),
+		Kind:      resource.GetKind(),
+		Name:      resource.GetName(),
+	}, nil
+}
@@ -0,0 +1,118 @@
+package action
+
+import (
+	"errors"
+
+	"bunnyshell.com/cli/cmd/environment/action"
+	"bunnyshell.com/cli/pkg/api/component"
+	"bunnyshell.com/cli/pkg/api/environment"
+	"bunnyshell.com/cli/pkg/api/k8s"
+	componentPkg "bunnyshell.com/cli/pkg/component"
+	"bunnyshell.com/cli/pkg/config"
+	environmentPkg "bunnyshell.com/cli/pkg/environment"
+	"bunnyshell.com/cli/pkg/lib"
+	"bunnyshell.com/cli/pkg/util"
+	"bunnyshell.com/sdk"
+	"github.com/spf13/cobra"
+)
+
+var errInvalidPort = errors.New("the port must be between 1 and 65535")
+
+func init() {
+	settings := config.GetSettings()
+	options := config.GetOptions()
+
+	exposeOptions := componentPkg.ExposeOptions{}
+	editConfigurationOptions := environment.NewEditConfigurationOptions("")
+	noDeploy := false
+	k8sIntegration := ""
+
+	command := &cobra.Command{
+		Use: "expose",
+
+		Short: "Expose a component port publicly, or remove the exposure",
+		Long: "Expose a component port publicly, or remove the exposure with --remove.\n" +
+			"The host is added to the component in the environment definition, then only this component is deployed and the environment endpoints are shown.\n" +
+			"The hostname defaults to one derived from the component name within the environment domain.",
+
+		Example: "expose --id ComponentID --port 8080\nexpose --id ComponentID --port 8080 --remove",
+
+		ValidArgsFunction: cobra.NoFileCompletions,
+
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if exposeOptions.Port < 1 || exposeOptions.Port > 65535 {
+				return errInvalidPort
+			}
+
+			return nil
+		},
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			model, err := component.Get(component.NewItemOptions(settings.Profile.Context.ServiceComponent))
+			if err != nil {
+				return lib.FormatCommandError(cmd, err)
+			}
+
+			definition, err := environment.Definition(environment.NewDefinitionOptions(model.GetEnvironment()))
+			if err != nil {
+				return lib.FormatCommandError(cmd, err)
+			}
+
+			content, err := componentPkg.ExposeInDefinition(definition.Bytes, model.GetName(), exposeOptions)
+			if err != nil {
+				return lib.FormatCommandError(cmd, err)
+			}
+
+			// the exposure can be rolled back with the environment definition history
+			if _, err = environmentPkg.RecordRevision(model.GetEnvironment()); err != nil {
+				cmd.PrintErrf("Could not save the current definition of environment %s: %s\n", model.GetEnvironment(), err)
+			}
+
+			yaml := string(content)
+			fromString := sdk.NewFromString()
+			fromString.Yaml = &yaml
+
+			editConfigurationOptions.ID = model.GetEnvironment()
+			editConfigurationOptions.Configuration = &sdk.EnvironmentEditConfigurationConfiguration{
+				FromString: fromString,
+			}
+
+			environmentModel, err := environment.EditConfiguration(editConfigurationOptions)
+			if err != nil {
+				return lib.FormatCommandError(cmd, err)
+			}
+
+			if noDeploy {
+				return lib.FormatCommandData(cmd, environmentModel)
+			}
+
+			deployOptions := &editConfigurationOptions.DeployOptions
+			deployOptions.ID = environmentModel.GetId()
+			deployOptions.SetComponents(model.GetName())
+
+			return action.HandleDeploy(cmd, deployOptions, "updated", k8sIntegration, settings.IsStylish())
+		},
+	}
+
+	flags := command.Flags()
+
+	flags.AddFlag(options.ServiceComponent.GetRequiredFlag("id"))
+
+	flags.IntVar(&exposeOptions.Port, "port", exposeOptions.Port, "Service port of the component to expose")
+	util.MarkFlagRequiredWithHelp(flags.Lookup("port"), "The service port of the component to expose")
+
+	flags.StringVar(&exposeOptions.Hostname, "hostname", exposeOptions.Hostname, "Public hostname, templates such as {{ env.base_domain }} are supported")
+	flags.StringVar(&exposeOptions.Path, "path", exposeOptions.Path, "Public path, defaults to /")
+	flags.BoolVar(&exposeOptions.Remove, "remove", exposeOptions.Remove, "Remove the exposure of the port")
+	command.MarkFlagsMutuallyExclusive("remove", "hostname")
+	command.MarkFlagsMutuallyExclusive("remove", "path")
+
+	flags.BoolVar(&noDeploy, "no-deploy", noDeploy, "Only update the environment definition, the exposure applies on the next deployment")
+	flags.StringVar(&k8sIntegration, "k8s", k8sIntegration, "Set Kubernetes integration for the environment (if not set)")
+
+	editConfigurationOptions.DeployOptions.ActionOptions.UpdateFlagSet(flags)
+
+	k8s.RegisterFlagCompletion(command, "k8s")
+
+	mainCmd.AddCommand(command)
+}
@@ -0,0 +1,69 @@
+package action
+
+import (
+	"bunnyshell.com/cli/pkg/component"
+	"bunnyshell.com/cli/pkg/config"
+	"bunnyshell.com/cli/pkg/lib"
+	"github.com/spf13/cobra"
+)
+
+type scaleToggle func(componentID string, options component.RolloutOptions) (component.PodStatuses, error)
+
+func init() {
+	mainCmd.AddCommand(newScaleToggleCommand(
+		"pause",
+		"Scale the component to zero replicas, until resumed",
+		"Pausing",
+		component.PauseComponent,
+	))
+
+	mainCmd.AddCommand(newScaleToggleCommand(
+		"resume",
+		"Scale a paused component back to the replicas it had",
+		"Resuming",
+		component.ResumeComponent,
+	))
+}
+
+func newScaleToggleCommand(use string, short string, progress string, toggle scaleToggle) *cobra.Command {
+	options := config.GetOptions()
+	settings := config.GetSettings()
+
+	rolloutOptions := component.RolloutOptions{
+		Interval: restartPollInterval,
+		Timeout:  defaultRestartTimeout,
+	}
+
+	command := &cobra.Command{
+		Use: use,
+
+		Short: short,
+		Long:  short + ".\nThe next environment deployment applies the replicas from the definition again.",
+
+		ValidArgsFunction: cobra.NoFileCompletions,
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			componentID := settings.Profile.Context.ServiceComponent
+
+			if settings.IsStylish() {
+				cmd.Printf("%s component %s...\n\n", progress, componentID)
+			}
+
+			statuses, err := toggle(componentID, rolloutOptions)
+			if err != nil {
+				return lib.FormatCommandError(cmd, err)
+			}
+
+			return lib.FormatCommandData(cmd, statuses)
+		},
+	}
+
+	flags := command.Flags()
+
+	flags.AddFlag(options.ServiceComponent.GetRequiredFlag("id"))
+
+	flags.BoolVar(&rolloutOptions.Wait, "wait", rolloutOptions.Wait, "Wait for the rollout to complete")
+	flags.DurationVar(&rolloutOptions.Timeout, "wait-timeout", rolloutOptions.Timeout, "How long to wait for the rollout, 0 waits forever")
+
+	return command
+}
@@ -11,7 +11,10 @@ import (
 	"github.com/spf13/cobra"
 )
 
-var errInvalidPortMapping = errors.New("invalid port mapping")
+var (
+	errInvalidPortMapping = errors.New("invalid port mapping")
+	errNoPortMappings     = errors.New("at least one port mapping is required, as argument or --port")
+)
 
 func init() {
 	options := config.GetOptions()
@@ -20,20 +23,22 @@ func init() {
 	var (
 		resourcePath string
 		podName      string
+		ports        []string
 	)
 
 	command := &cobra.Command{
-		Use:     "port-forward mappings...",
+		Use:     "port-forward [mappings...]",
 		Aliases: []string{"pfwd"},
 
 		Short:   "Starts the port forwarding for the given mappings.",
-		Example: "start 8080:80 3306 :9003",
+		Example: "port-forward --id ComponentID --port 8080:80 --port 3306 --port :9003",
 
 		ValidArgsFunction: cobra.NoFileCompletions,
 
-		Args: func(cmd *cobra.Command, portMappings []string) error {
-			if err := cobra.MinimumNArgs(1)(cmd, portMappings); err != nil {
-				return err
+		Args: func(cmd *cobra.Command, args []string) error {
+			portMappings := getPortMappings(ports, args)
+			if len(portMappings) == 0 {
+				return errNoPortMappings
 			}
 
 			for _, portMapping := range portMappings {
@@ -45,14 +50,14 @@ func init() {
 			return nil
 		},
 
-		RunE: func(cmd *cobra.Command, portMappings []string) error {
+		RunE: func(cmd *cobra.Command, args []string) error {
 			if podName == "" && config.GetSettings().NonInteractive {
 				return interactive.ErrNonInteractive
 			}
 
 			portForwardManager := port_forward.NewPortForwardManager()
 
-			portForwardManager.WithPortMappings(portMappings)
+			portForwardManager.WithPortMappings(getPortMappings(ports, args))
 
 			environmentResource, err := environment.NewFromWizard(&settings.Profile.Context, resourcePath)
 			if err != nil {
@@ -88,6 +93,13 @@ func init() {
 
 	flags.StringVarP(&resourcePath, "resource", "s", "", "The cluster resource to use (namespace/kind/name format).")
 	flags.StringVar(&podName, "pod", "", "The resource pod to forward ports to.")
+	flags.StringArrayVarP(&ports, "port", "p", ports, "Port mapping as local:remote, a single port uses the same one locally (repeatable)")
 
 	mainCmd.AddCommand(command)
 }
+
+func getPortMappings(ports []string, args []string) []string {
+	portMappings := make([]string, 0, len(ports)+len(args))
+
+	return append(append(portMappings, ports...), args...)
+}
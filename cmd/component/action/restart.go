@@ -0,0 +1,60 @@
+package action
+
+import (
+	"time"
+
+	"bunnyshell.com/cli/pkg/component"
+	"bunnyshell.com/cli/pkg/config"
+	"bunnyshell.com/cli/pkg/lib"
+	"github.com/spf13/cobra"
+)
+
+const (
+	restartPollInterval   = 2 * time.Second
+	defaultRestartTimeout = 5 * time.Minute
+)
+
+func init() {
+	options := config.GetOptions()
+	settings := config.GetSettings()
+
+	noWait := false
+	restartOptions := component.RolloutOptions{
+		Interval: restartPollInterval,
+		Timeout:  defaultRestartTimeout,
+	}
+
+	command := &cobra.Command{
+		Use: "restart",
+
+		Short: "Restart the component workloads and wait for the new pods to become ready",
+		Long:  "Restart the component workloads and wait for the new pods to become ready.\nEach Deployment, StatefulSet and DaemonSet of the component gets a rollout restart.",
+
+		ValidArgsFunction: cobra.NoFileCompletions,
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			componentID := settings.Profile.Context.ServiceComponent
+			restartOptions.Wait = !noWait
+
+			if settings.IsStylish() {
+				cmd.Printf("Restarting component %s...\n\n", componentID)
+			}
+
+			statuses, err := component.RestartComponent(componentID, restartOptions)
+			if err != nil {
+				return lib.FormatCommandError(cmd, err)
+			}
+
+			return lib.FormatCommandData(cmd, statuses)
+		},
+	}
+
+	flags := command.Flags()
+
+	flags.AddFlag(options.ServiceComponent.GetRequiredFlag("id"))
+
+	flags.BoolVar(&noWait, "no-wait", noWait, "Do not wait for the new pods to become ready")
+	flags.DurationVar(&restartOptions.Timeout, "wait-timeout", restartOptions.Timeout, "How long to wait for the new pods to become ready, 0 waits forever")
+
+	mainCmd.AddCommand(command)
+}
@@ -0,0 +1,92 @@
+package action
+
+import (
+	"errors"
+
+	"bunnyshell.com/cli/pkg/api/component"
+	"bunnyshell.com/cli/pkg/api/pipeline"
+	"bunnyshell.com/cli/pkg/config"
+	"bunnyshell.com/cli/pkg/lib"
+	"bunnyshell.com/cli/pkg/progress"
+	"github.com/spf13/cobra"
+)
+
+var errNoPipelineInProgress = errors.New("no pipeline in progress for the component environment")
+
+func init() {
+	command := &cobra.Command{
+		Use: "rollout",
+
+		Short: "Follow the deployments of a component",
+
+		ValidArgsFunction: cobra.NoFileCompletions,
+	}
+
+	command.AddCommand(newRolloutStatusCommand())
+
+	mainCmd.AddCommand(command)
+}
+
+func newRolloutStatusCommand() *cobra.Command {
+	options := config.GetOptions()
+	settings := config.GetSettings()
+
+	command := &cobra.Command{
+		Use: "status",
+
+		Short: "Attach to the in-progress deployment of the component environment",
+		Long: "Attach to the in-progress deployment of the component environment, following the pipeline stage by stage until it finishes.\n" +
+			"Useful to follow deployments triggered outside the CLI, such as by a git push.",
+
+		ValidArgsFunction: cobra.NoFileCompletions,
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			model, err := component.Get(component.NewItemOptions(settings.Profile.Context.ServiceComponent))
+			if err != nil {
+				return lib.FormatCommandError(cmd, err)
+			}
+
+			pipelineID, err := getInProgressPipelineID(model.GetEnvironment())
+			if err != nil {
+				return lib.FormatCommandError(cmd, err)
+			}
+
+			if err = progress.Pipeline(pipelineID, nil); err != nil {
+				return err
+			}
+
+			pipelineItem, err := pipeline.Get(pipeline.NewItemOptions(pipelineID))
+			if err != nil {
+				return lib.FormatCommandError(cmd, err)
+			}
+
+			return lib.FormatCommandData(cmd, pipelineItem)
+		},
+	}
+
+	flags := command.Flags()
+
+	flags.AddFlag(options.ServiceComponent.GetRequiredFlag("id"))
+
+	return command
+}
+
+// getInProgressPipelineID prefers a running pipeline over one still waiting to start.
+func getInProgressPipelineID(environmentID string) (string, error) {
+	for _, status := range []string{progress.StatusInProgress, progress.StatusPending} {
+		listOptions := pipeline.NewListOptions()
+		listOptions.Environment = environmentID
+		listOptions.Status = status
+
+		collection, err := pipeline.List(listOptions)
+		if err != nil {
+			return "", err
+		}
+
+		if collection.HasEmbedded() && len(collection.Embedded.GetItem()) > 0 {
+			return collection.Embedded.GetItem()[0].GetId(), nil
+		}
+	}
+
+	return "", errNoPipelineInProgress
+}
@@ -0,0 +1,69 @@
+package action
+
+import (
+	"errors"
+
+	"bunnyshell.com/cli/pkg/component"
+	"bunnyshell.com/cli/pkg/config"
+	"bunnyshell.com/cli/pkg/lib"
+	"github.com/spf13/cobra"
+)
+
+var errNegativeReplicas = errors.New("--replicas cannot be negative")
+
+func init() {
+	options := config.GetOptions()
+	settings := config.GetSettings()
+
+	replicas := int32(0)
+	scaleOptions := component.RolloutOptions{
+		Interval: restartPollInterval,
+		Timeout:  defaultRestartTimeout,
+	}
+
+	command := &cobra.Command{
+		Use: "scale",
+
+		Short: "Set the replica count of the component workloads",
+		Long:  "Set the replica count of the component Deployments and StatefulSets.\nThe next environment deployment applies the replicas from the definition again.",
+
+		Example: "scale --id ComponentID --replicas 3 --wait",
+
+		ValidArgsFunction: cobra.NoFileCompletions,
+
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if replicas < 0 {
+				return errNegativeReplicas
+			}
+
+			return nil
+		},
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			componentID := settings.Profile.Context.ServiceComponent
+
+			if settings.IsStylish() {
+				cmd.Printf("Scaling component %s to %d replicas...\n\n", componentID, replicas)
+			}
+
+			statuses, err := component.ScaleComponent(componentID, replicas, scaleOptions)
+			if err != nil {
+				return lib.FormatCommandError(cmd, err)
+			}
+
+			return lib.FormatCommandData(cmd, statuses)
+		},
+	}
+
+	flags := command.Flags()
+
+	flags.AddFlag(options.ServiceComponent.GetRequiredFlag("id"))
+
+	flags.Int32Var(&replicas, "replicas", replicas, "Number of replicas")
+	_ = command.MarkFlagRequired("replicas")
+
+	flags.BoolVar(&scaleOptions.Wait, "wait", scaleOptions.Wait, "Wait for the rollout to complete")
+	flags.DurationVar(&scaleOptions.Timeout, "wait-timeout", scaleOptions.Timeout, "How long to wait for the rollout, 0 waits forever")
+
+	mainCmd.AddCommand(command)
+}
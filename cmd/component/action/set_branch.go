@@ -0,0 +1,80 @@
+package action
+
+import (
+	"bunnyshell.com/cli/cmd/environment/action"
+	"bunnyshell.com/cli/pkg/api/component"
+	"bunnyshell.com/cli/pkg/api/environment"
+	"bunnyshell.com/cli/pkg/api/k8s"
+	"bunnyshell.com/cli/pkg/config"
+	"bunnyshell.com/cli/pkg/lib"
+	"bunnyshell.com/cli/pkg/util"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	settings := config.GetSettings()
+	options := config.GetOptions()
+
+	editOptions := environment.NewEditComponentOptions()
+	k8sIntegration := ""
+
+	command := &cobra.Command{
+		Use: "set-branch",
+
+		Short: "Switch the git branch the component builds from",
+		Long:  "Switch the git branch the component builds from, keeping its repository.\nWith --deploy, only this component is rebuilt and deployed.",
+
+		Example: "set-branch --id ComponentID --branch feature/login --deploy",
+
+		ValidArgsFunction: cobra.NoFileCompletions,
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			model, err := component.Get(component.NewItemOptions(settings.Profile.Context.ServiceComponent))
+			if err != nil {
+				return lib.FormatCommandError(cmd, err)
+			}
+
+			if settings.IsStylish() {
+				cmd.Printf(`Switching component "%s" (%s) to branch %s%s`, model.GetName(), model.GetId(), editOptions.TargetBranch, "\n\n")
+			}
+
+			editOptions.ID = model.GetEnvironment()
+			editOptions.Component = model.GetName()
+
+			if _, err = environment.EditComponents(editOptions); err != nil {
+				return lib.FormatCommandError(cmd, err)
+			}
+
+			if !editOptions.WithDeploy {
+				updated, err := component.Get(component.NewItemOptions(model.GetId()))
+				if err != nil {
+					return lib.FormatCommandError(cmd, err)
+				}
+
+				return lib.FormatCommandData(cmd, updated)
+			}
+
+			deployOptions := &editOptions.DeployOptions
+			deployOptions.ID = model.GetEnvironment()
+			deployOptions.SetComponents(model.GetName())
+
+			return action.HandleDeploy(cmd, deployOptions, "updated", k8sIntegration, settings.IsStylish())
+		},
+	}
+
+	flags := command.Flags()
+
+	flags.AddFlag(options.ServiceComponent.GetRequiredFlag("id"))
+
+	flags.StringVar(&editOptions.TargetBranch, "branch", editOptions.TargetBranch, "Git branch to build the component from")
+	util.MarkFlagRequiredWithHelp(flags.Lookup("branch"), "The git branch to build the component from")
+
+	flags.BoolVar(&editOptions.WithDeploy, "deploy", editOptions.WithDeploy, "Rebuild and deploy the component after the update")
+	flags.StringVar(&k8sIntegration, "k8s", k8sIntegration, "Set Kubernetes integration for the environment (if not set)")
+
+	editOptions.DeployOptions.ActionOptions.UpdateFlagSet(flags)
+
+	k8s.RegisterFlagCompletion(command, "k8s")
+
+	mainCmd.AddCommand(command)
+}
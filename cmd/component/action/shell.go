@@ -0,0 +1,46 @@
+package action
+
+import (
+	"bunnyshell.com/cli/pkg/config"
+	"github.com/spf13/cobra"
+)
+
+// bash when the image has it, sh otherwise
+var shellDetectCommand = []string{
+	"/bin/sh",
+	"-c",
+	"if command -v bash >/dev/null 2>&1; then exec bash; else exec sh; fi",
+}
+
+func init() {
+	options := config.GetOptions()
+	settings := config.GetSettings()
+
+	execOptions := ExecOptions{}
+
+	command := &cobra.Command{
+		Use: "shell",
+
+		Short: "Open an interactive shell in a component container",
+		Long:  "Open an interactive shell in a component container, bash when available, sh otherwise.\nThe pod is resolved from the selected Deployment, StatefulSet, DaemonSet, Job or CronJob resource.",
+
+		Example: "shell --id ComponentID --resource namespace/deployment/name",
+
+		Args: cobra.NoArgs,
+
+		ValidArgsFunction: cobra.NoFileCompletions,
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runExec(settings.Profile.Context.ServiceComponent, &execOptions, shellDetectCommand)
+		},
+	}
+
+	flags := command.Flags()
+
+	flags.AddFlag(options.ServiceComponent.GetRequiredFlag("id"))
+
+	execOptions.UpdateFlagSet(flags)
+	command.MarkFlagsMutuallyExclusive("resource", "pod")
+
+	mainCmd.AddCommand(command)
+}
@@ -0,0 +1,37 @@
+package action
+
+import (
+	"bunnyshell.com/cli/pkg/component"
+	"bunnyshell.com/cli/pkg/config"
+	"bunnyshell.com/cli/pkg/lib"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	options := config.GetOptions()
+	settings := config.GetSettings()
+
+	command := &cobra.Command{
+		Use: "top",
+
+		Short: "Show the CPU and memory usage of the component pods",
+		Long:  "Show the CPU and memory usage of the running component pods, along with the container limits.\nThe cluster needs a metrics server.",
+
+		ValidArgsFunction: cobra.NoFileCompletions,
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			usage, err := component.TopComponent(settings.Profile.Context.ServiceComponent)
+			if err != nil {
+				return lib.FormatCommandError(cmd, err)
+			}
+
+			return lib.FormatCommandData(cmd, usage)
+		},
+	}
+
+	flags := command.Flags()
+
+	flags.AddFlag(options.ServiceComponent.GetRequiredFlag("id"))
+
+	mainCmd.AddCommand(command)
+}
@@ -7,6 +7,7 @@ import (
 	"bunnyshell.com/cli/pkg/api/common"
 	"bunnyshell.com/cli/pkg/api/component"
 	"bunnyshell.com/cli/pkg/api/environment"
+	"bunnyshell.com/cli/pkg/api/k8s"
 	"bunnyshell.com/cli/pkg/build"
 	"bunnyshell.com/cli/pkg/config"
 	githelper "bunnyshell.com/cli/pkg/helper/git"
@@ -106,5 +107,7 @@ func init() {
 	flags.BoolVar(&editComponentsData.WithDeploy, "deploy", editComponentsData.WithDeploy, "Deploy the environment after update")
 	flags.StringVar(&editComponentsData.K8SIntegration, "k8s", editComponentsData.K8SIntegration, "Set Kubernetes integration for the environment (if not set)")
 
+	k8s.RegisterFlagCompletion(command, "k8s")
+
 	mainCmd.AddCommand(command)
 }
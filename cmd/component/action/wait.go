@@ -0,0 +1,106 @@
+package action
+
+import (
+	"errors"
+
+	"bunnyshell.com/cli/pkg/component"
+	"bunnyshell.com/cli/pkg/config"
+	"bunnyshell.com/cli/pkg/lib"
+	"github.com/spf13/cobra"
+)
+
+const (
+	waitCrashLoopExitCode = 2
+	waitTimeoutExitCode   = 3
+)
+
+func init() {
+	options := config.GetOptions()
+	settings := config.GetSettings()
+
+	condition := ""
+	waitOptions := component.ComponentWaitOptions{
+		Interval: restartPollInterval,
+		Timeout:  defaultRestartTimeout,
+	}
+
+	command := &cobra.Command{
+		Use: "wait",
+
+		Short: "Wait until the component reaches a state",
+		Long: "Wait until the component reaches a state: " + component.JoinComponentWaitConditions() + ".\n" +
+			"A component is ready once all the pods of its Deployments, StatefulSets and DaemonSets are ready.\n" +
+			"Exits with 2 when a pod is crash looping and with 3 when the wait timeout is reached.",
+
+		Example: "wait --id ComponentID --for ready --wait-timeout 10m",
+
+		ValidArgsFunction: cobra.NoFileCompletions,
+
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if err := lib.ValidateInterval(waitOptions.Interval); err != nil {
+				return err
+			}
+
+			waitCondition, err := component.ParseComponentWaitCondition(condition)
+			if err != nil {
+				return err
+			}
+
+			waitOptions.Condition = waitCondition
+
+			return nil
+		},
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			componentID := settings.Profile.Context.ServiceComponent
+
+			waitOptions.OnChange = func(statuses component.PodStatuses) {
+				if !settings.IsStylish() {
+					return
+				}
+
+				cmd.Printf("Component %s pods:\n", componentID)
+
+				_ = lib.FormatCommandData(cmd, statuses)
+			}
+
+			statuses, err := component.WaitComponent(componentID, waitOptions)
+
+			switch {
+			case errors.Is(err, component.ErrCrashLoop):
+				return lib.NewExitCodeError(waitCrashLoopExitCode, err)
+			case errors.Is(err, component.ErrRolloutTimeout):
+				return lib.NewExitCodeError(waitTimeoutExitCode, err)
+			case err != nil:
+				return lib.FormatCommandError(cmd, err)
+			}
+
+			if settings.IsStylish() {
+				return nil
+			}
+
+			return lib.FormatCommandData(cmd, statuses)
+		},
+	}
+
+	flags := command.Flags()
+
+	flags.AddFlag(options.ServiceComponent.GetRequiredFlag("id"))
+
+	flags.StringVar(&condition, "for", condition, "Condition to wait for: "+component.JoinComponentWaitConditions())
+	flags.DurationVar(&waitOptions.Interval, "interval", waitOptions.Interval, "Status check interval")
+	flags.DurationVar(&waitOptions.Timeout, "wait-timeout", waitOptions.Timeout, "Stop waiting after the given duration, 0 waits forever")
+
+	_ = command.MarkFlagRequired("for")
+
+	_ = command.RegisterFlagCompletionFunc("for", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		conditions := make([]string, 0, len(component.ComponentWaitConditions))
+		for _, item := range component.ComponentWaitConditions {
+			conditions = append(conditions, string(item))
+		}
+
+		return conditions, cobra.ShellCompDirectiveNoFileComp
+	})
+
+	mainCmd.AddCommand(command)
+}
@@ -0,0 +1,37 @@
+package component
+
+import (
+	"bunnyshell.com/cli/pkg/component"
+	"bunnyshell.com/cli/pkg/config"
+	"bunnyshell.com/cli/pkg/lib"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	options := config.GetOptions()
+	settings := config.GetSettings()
+
+	command := &cobra.Command{
+		Use:     "describe",
+		GroupID: mainGroup.ID,
+
+		Short: "Show a component with its git source, images, resources and pods",
+
+		ValidArgsFunction: cobra.NoFileCompletions,
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			model, err := component.DescribeComponent(settings.Profile.Context.ServiceComponent)
+			if err != nil {
+				return lib.FormatCommandError(cmd, err)
+			}
+
+			return lib.FormatCommandData(cmd, model)
+		},
+	}
+
+	flags := command.Flags()
+
+	flags.AddFlag(options.ServiceComponent.GetRequiredFlag("id"))
+
+	mainCmd.AddCommand(command)
+}
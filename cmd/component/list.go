@@ -2,6 +2,7 @@ package component
 
 import (
 	"bunnyshell.com/cli/pkg/api/component"
+	"bunnyshell.com/cli/pkg/api/project"
 	"bunnyshell.com/cli/pkg/config"
 	"bunnyshell.com/cli/pkg/lib"
 	"github.com/spf13/cobra"
@@ -12,6 +13,11 @@ func init() {
 	settings := config.GetSettings()
 
 	listOptions := component.NewListOptions()
+	aggregateOptions := component.NewAggregateOptions()
+
+	allProjects := false
+
+	onelineOptions := lib.OnelineOptions{}
 
 	command := &cobra.Command{
 		Use:     "list",
@@ -24,6 +30,27 @@ func init() {
 			listOptions.Project = settings.Profile.Context.Project
 			listOptions.Environment = settings.Profile.Context.Environment
 
+			aggregateOptions.ListOptions = *listOptions
+
+			onelineOptions.Apply()
+
+			if allProjects {
+				// the context project and environment would narrow every project listing
+				aggregateOptions.Project = ""
+				aggregateOptions.Environment = ""
+
+				return showAllProjects(cmd, aggregateOptions)
+			}
+
+			if aggregateOptions.Search != "" {
+				items, err := component.ListAll(aggregateOptions.ListOptions)
+				if err != nil {
+					return lib.FormatCommandError(cmd, err)
+				}
+
+				return lib.FormatCommandData(cmd, aggregateOptions.Filter(items))
+			}
+
 			return lib.ShowCollection(cmd, listOptions, func() (lib.ModelWithPagination, error) {
 				return component.List(listOptions)
 			})
@@ -36,7 +63,27 @@ func init() {
 	flags.AddFlag(options.Project.GetFlag("project"))
 	flags.AddFlag(options.Environment.GetFlag("environment"))
 
+	flags.BoolVar(&allProjects, "all-projects", allProjects, "List components from all projects within the organization, ignoring --project and --environment")
+
 	listOptions.UpdateFlagSet(flags)
+	command.MarkFlagsMutuallyExclusive("status", "clusterStatus")
+	command.MarkFlagsMutuallyExclusive("operation-status", "operationStatus")
+	command.MarkFlagsMutuallyExclusive("name", "componentName")
+
+	aggregateOptions.UpdateFlagSet(flags)
+	onelineOptions.UpdateFlagSet(flags)
 
 	mainCmd.AddCommand(command)
 }
+
+func showAllProjects(cmd *cobra.Command, options *component.AggregateOptions) error {
+	items, failures, err := component.AggregateProjects(options)
+	if err != nil {
+		return lib.FormatCommandError(cmd, err)
+	}
+
+	return project.ShowAggregateListing(cmd, &project.AggregateListing{
+		Items:    items,
+		Failures: failures,
+	})
+}
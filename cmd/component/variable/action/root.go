@@ -17,6 +17,8 @@ var (
 	errMissingValue        = errors.New("the plain value must be provided")
 	errMultipleValueInputs = errors.New("the value must be provided either by argument or by stdin, not both")
 	errComponentRequired   = errors.New("either the 'component' or the 'component-name' arguments are required")
+
+	errComponentVariableNotFound = errors.New("component variable not found")
 )
 
 var mainCmd = &cobra.Command{}
@@ -101,7 +103,7 @@ func findComponentVariableByName(componentVariableName string, componentName str
 	}
 
 	if matchedComponentVariables.GetTotalItems() == 0 {
-		return nil, fmt.Errorf("component variable '%s' not found", componentVariableName)
+		return nil, fmt.Errorf("%w: %s", errComponentVariableNotFound, componentVariableName)
 	}
 
 	if matchedComponentVariables.GetTotalItems() > 1 {
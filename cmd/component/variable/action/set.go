@@ -0,0 +1,116 @@
+package action
+
+import (
+	"errors"
+	"strings"
+
+	"bunnyshell.com/cli/pkg/api/component_variable"
+	"bunnyshell.com/cli/pkg/config"
+	"bunnyshell.com/cli/pkg/config/enum"
+	"bunnyshell.com/cli/pkg/lib"
+	"bunnyshell.com/sdk"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	var componentName string
+
+	settings := config.GetSettings()
+
+	isSecret := false
+
+	command := &cobra.Command{
+		Use: "set NAME=value...",
+
+		Short: "Create or update component variables",
+		Long:  "Create or update component variables, the ones already defined on the component get the new value.",
+
+		Example: "set --component ComponentID DEBUG=1 LOG_LEVEL=info",
+
+		Args: cobra.MatchAll(cobra.MinimumNArgs(1), lib.VariablePairs),
+
+		ValidArgsFunction: cobra.NoFileCompletions,
+
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if !cmd.Flags().Changed("component-name") && settings.Profile.Context.ServiceComponent == "" {
+				return errComponentRequired
+			}
+
+			return nil
+		},
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			componentID := settings.Profile.Context.ServiceComponent
+
+			if componentName != "" {
+				component, err := findComponentByName(componentName, &settings.Profile)
+				if err != nil {
+					return err
+				}
+
+				componentID = component.GetId()
+			}
+
+			for _, pair := range args {
+				name, value, _ := strings.Cut(pair, "=")
+
+				if _, err := setComponentVariable(componentID, name, value, isSecret, &settings.Profile); err != nil {
+					return lib.FormatCommandError(cmd, err)
+				}
+
+				cmd.Printf("Component variable %s successfully set\n", name)
+			}
+
+			return nil
+		},
+	}
+
+	flags := command.Flags()
+
+	updateComponentIdentifierFlags(command, &componentName)
+
+	flags.BoolVar(&isSecret, "secret", isSecret, "Set the variables as secrets")
+
+	mainCmd.AddCommand(command)
+}
+
+func setComponentVariable(componentID string, name string, value string, isSecret bool, profile *config.Profile) (*sdk.ServiceComponentVariableItem, error) {
+	existing, err := findComponentVariable(componentID, name, profile)
+	if err != nil {
+		return nil, err
+	}
+
+	if existing == nil {
+		createOptions := component_variable.NewCreateOptions()
+		createOptions.ServiceComponent = componentID
+		createOptions.Name = name
+		createOptions.Value = value
+
+		if isSecret {
+			createOptions.IsSecret = enum.BoolTrue
+		}
+
+		return component_variable.Create(createOptions)
+	}
+
+	editOptions := component_variable.NewEditOptions(existing.GetId())
+	editOptions.ServiceComponentVariableEditAction.SetValue(value)
+
+	if isSecret {
+		editOptions.IsSecret = enum.BoolTrue
+	}
+
+	return component_variable.Edit(editOptions)
+}
+
+func findComponentVariable(componentID string, name string, profile *config.Profile) (*sdk.ServiceComponentVariableCollection, error) {
+	componentProfile := *profile
+	componentProfile.Context.ServiceComponent = componentID
+
+	existing, err := findComponentVariableByName(name, "", &componentProfile)
+	if errors.Is(err, errComponentVariableNotFound) {
+		return nil, nil
+	}
+
+	return existing, err
+}
@@ -0,0 +1,81 @@
+package cmd
+
+import (
+	"bunnyshell.com/cli/pkg/api/component"
+	"bunnyshell.com/cli/pkg/api/environment"
+	"bunnyshell.com/cli/pkg/api/organization"
+	"bunnyshell.com/cli/pkg/api/project"
+	"bunnyshell.com/cli/pkg/config"
+	"bunnyshell.com/cli/pkg/lib"
+	"bunnyshell.com/sdk"
+)
+
+// resolveContextNames replaces the --*-name flags with the ids of the matching resources.
+func resolveContextNames() error {
+	options := config.GetOptions()
+	settings := config.GetSettings()
+
+	names := settings.ContextNames
+	context := &settings.Profile.Context
+
+	return lib.ResolveNames([]lib.NameResolver{
+		{
+			Kind:   "organization",
+			Name:   names.Organization,
+			Option: options.Organization,
+			Lookup: func(name string) ([]lib.NamedResource, error) {
+				listOptions := organization.NewListOptions()
+				listOptions.Search = name
+
+				items, err := organization.ListAll(*listOptions)
+
+				return lib.ToNamedResources[sdk.OrganizationCollection](items), err
+			},
+		},
+		{
+			Kind:   "project",
+			Name:   names.Project,
+			Option: options.Project,
+			Lookup: func(name string) ([]lib.NamedResource, error) {
+				listOptions := project.NewListOptions()
+				listOptions.Organization = context.Organization
+				listOptions.Search = name
+
+				items, err := project.ListAll(*listOptions)
+
+				return lib.ToNamedResources[sdk.ProjectCollection](items), err
+			},
+		},
+		{
+			Kind:   "environment",
+			Name:   names.Environment,
+			Option: options.Environment,
+			Lookup: func(name string) ([]lib.NamedResource, error) {
+				listOptions := environment.NewListOptions()
+				listOptions.Organization = context.Organization
+				listOptions.Project = context.Project
+				listOptions.Search = name
+
+				items, err := environment.ListAll(*listOptions)
+
+				return lib.ToNamedResources[sdk.EnvironmentCollection](items), err
+			},
+		},
+		{
+			Kind:   "component",
+			Name:   names.ServiceComponent,
+			Option: options.ServiceComponent,
+			Lookup: func(name string) ([]lib.NamedResource, error) {
+				listOptions := component.NewListOptions()
+				listOptions.Organization = context.Organization
+				listOptions.Project = context.Project
+				listOptions.Environment = context.Environment
+				listOptions.Name = name
+
+				items, err := component.ListAll(*listOptions)
+
+				return lib.ToNamedResources[sdk.ComponentCollection](items), err
+			},
+		},
+	})
+}
@@ -0,0 +1,29 @@
+package action
+
+import (
+	"bunnyshell.com/cli/pkg/api/environment"
+	"bunnyshell.com/cli/pkg/config/enum"
+	"bunnyshell.com/sdk"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	command := &cobra.Command{
+		Use: "auto-update",
+
+		Short: "Control whether the environment is updated when the components git refs change",
+	}
+
+	command.AddCommand(newAutoUpdateCommand("enable", "Turn on the environment auto-update", enum.BoolTrue))
+	command.AddCommand(newAutoUpdateCommand("disable", "Turn off the environment auto-update", enum.BoolFalse))
+
+	mainCmd.AddCommand(command)
+}
+
+func newAutoUpdateCommand(use string, short string, autoUpdate enum.Bool) *cobra.Command {
+	return newSettingsToggleCommand(use, short, func(editSettingsOptions *environment.EditSettingsOptions, _ *sdk.EnvironmentItem) error {
+		editSettingsOptions.AutoUpdate = autoUpdate
+
+		return nil
+	})
+}
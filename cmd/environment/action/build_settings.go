@@ -0,0 +1,48 @@
+package action
+
+import (
+	"bunnyshell.com/cli/pkg/api/environment"
+	"bunnyshell.com/cli/pkg/config"
+	"bunnyshell.com/cli/pkg/lib"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	options := config.GetOptions()
+	settings := config.GetSettings()
+
+	itemOptions := environment.NewItemOptions("")
+
+	command := &cobra.Command{
+		Use: "build-settings",
+
+		Short: "Show the build cluster, registry and builder resources of the environment",
+		Long:  "Show the build cluster, registry and builder resources of the environment.\nChange them with update-build-settings.",
+
+		ValidArgsFunction: cobra.NoFileCompletions,
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			itemOptions.ID = settings.Profile.Context.Environment
+
+			model, err := environment.Get(itemOptions)
+			if err != nil {
+				return lib.FormatCommandError(cmd, err)
+			}
+
+			buildSettings, ok := model.GetBuildSettingsOk()
+			if (!ok || buildSettings == nil) && settings.IsStylish() {
+				cmd.Printf("Environment %s uses the project build cluster and registry\n", itemOptions.ID)
+
+				return nil
+			}
+
+			return lib.FormatCommandData(cmd, buildSettings)
+		},
+	}
+
+	flags := command.Flags()
+
+	flags.AddFlag(options.Environment.GetRequiredFlag("id"))
+
+	mainCmd.AddCommand(command)
+}
@@ -0,0 +1,39 @@
+package action
+
+import (
+	"errors"
+
+	environmentBulk "bunnyshell.com/cli/pkg/environment"
+	"bunnyshell.com/cli/pkg/interactive"
+	"bunnyshell.com/cli/pkg/lib"
+	"bunnyshell.com/cli/pkg/util"
+	"github.com/spf13/cobra"
+)
+
+const bulkActionConcurrency = 4
+
+var errBulkActionFailed = errors.New("the action failed for some environments")
+
+// requireIDWithoutArgs keeps --id required, unless the environments are given as arguments.
+func requireIDWithoutArgs(cmd *cobra.Command, args []string) {
+	if len(args) > 0 {
+		return
+	}
+
+	util.MarkFlag(cmd.Flags().Lookup("id"), util.FlagRequired)
+	interactive.AskMissingRequiredFlags(cmd)
+}
+
+func runBulkAction(cmd *cobra.Command, environmentIDs []string, wait bool, action environmentBulk.EnvironmentAction) error {
+	report := environmentBulk.RunAll(environmentIDs, bulkActionConcurrency, wait, action)
+
+	if err := lib.FormatCommandData(cmd, report); err != nil {
+		return err
+	}
+
+	if report.HasFailures() {
+		return errBulkActionFailed
+	}
+
+	return nil
+}
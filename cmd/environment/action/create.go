@@ -5,13 +5,16 @@ import (
 
 	"bunnyshell.com/cli/pkg/api/environment"
 	"bunnyshell.com/cli/pkg/config"
+	environmentPlan "bunnyshell.com/cli/pkg/environment"
 	"bunnyshell.com/cli/pkg/lib"
+	"bunnyshell.com/cli/pkg/progress"
 	"bunnyshell.com/cli/pkg/util"
 	"github.com/spf13/cobra"
 )
 
 var (
 	errK8SIntegrationNotProvided = errors.New("kubernetes integration must be provided when deploying")
+	errWaitRequiresDeploy        = errors.New("--wait-timeout and --poll-interval require --deploy")
 )
 
 func init() {
@@ -19,6 +22,8 @@ func init() {
 	settings := config.GetSettings()
 
 	createOptions := environment.NewCreateOptions()
+	progressOptions := progress.NewOptions()
+	dryRun := false
 
 	command := &cobra.Command{
 		Use: "create",
@@ -30,13 +35,18 @@ func init() {
 				return err
 			}
 
+			flags := cmd.Flags()
+			if !createOptions.WithDeploy && (flags.Changed("wait-timeout") || flags.Changed("poll-interval")) {
+				return errWaitRequiresDeploy
+			}
+
 			if createOptions.WithDeploy && createOptions.GetKubernetesIntegration() == "" {
 				if !settings.IsStylish() {
 					return errK8SIntegrationNotProvided
 				}
 			}
 
-			return validateActionOptions(&createOptions.ActionOptions)
+			return ValidateActionOptions(&createOptions.ActionOptions)
 		},
 
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -46,6 +56,23 @@ func init() {
 				return lib.FormatCommandError(cmd, err)
 			}
 
+			printComposeSkipped(cmd, createOptions.ComposeSkipped())
+
+			if dryRun {
+				return showCreatePlan(cmd, createOptions)
+			}
+
+			if createOptions.IfNotExists || createOptions.Upsert {
+				existing, err := environment.FindByName(createOptions.Project, createOptions.Name)
+				if err != nil {
+					return lib.FormatCommandError(cmd, err)
+				}
+
+				if existing != nil {
+					return handleExistingEnvironment(cmd, createOptions, existing.GetId(), progressOptions)
+				}
+			}
+
 			model, err := environment.Create(createOptions)
 			if err != nil {
 				return createOptions.HandleError(cmd, err)
@@ -58,7 +85,7 @@ func init() {
 			deployOptions := &createOptions.DeployOptions
 			deployOptions.ID = model.GetId()
 
-			return HandleDeploy(cmd, deployOptions, "created", createOptions.GetKubernetesIntegration(), settings.IsStylish())
+			return deployCreated(cmd, createOptions, deployOptions, "created", progressOptions)
 		},
 	}
 
@@ -72,6 +99,81 @@ func init() {
 	))
 
 	createOptions.UpdateCommandFlags(command)
+	command.MarkFlagsMutuallyExclusive("watch-only-on-failure", "no-wait")
+
+	flags.DurationVar(&progressOptions.Timeout, "wait-timeout", progressOptions.Timeout, "Fail if the deployment pipeline does not finish in time (0 waits indefinitely)")
+	flags.DurationVar(&progressOptions.Interval, "poll-interval", progressOptions.Interval, "Pipeline check interval")
+	command.MarkFlagsMutuallyExclusive("wait-timeout", "no-wait")
+	command.MarkFlagsMutuallyExclusive("poll-interval", "no-wait")
+
+	flags.BoolVar(&dryRun, "dry-run", dryRun, "Show what would be created without creating the environment, only a yaml definition is validated and only locally")
+	command.MarkFlagsMutuallyExclusive("dry-run", "deploy")
 
 	mainCmd.AddCommand(command)
 }
+
+func showCreatePlan(cmd *cobra.Command, createOptions *environment.CreateOptions) error {
+	plan := environmentPlan.PlanCreate(&createOptions.EnvironmentCreateAction)
+
+	if err := lib.FormatCommandData(cmd, plan); err != nil {
+		return err
+	}
+
+	if !plan.IsValid() {
+		return lib.NewExitCodeError(1, errInvalidDefinition)
+	}
+
+	return nil
+}
+
+func handleExistingEnvironment(
+	cmd *cobra.Command,
+	createOptions *environment.CreateOptions,
+	environmentID string,
+	progressOptions *progress.Options,
+) error {
+	if createOptions.IfNotExists {
+		model, err := environment.Get(environment.NewItemOptions(environmentID))
+		if err != nil {
+			return lib.FormatCommandError(cmd, err)
+		}
+
+		return lib.FormatCommandData(cmd, model)
+	}
+
+	editConfigurationOptions := createOptions.ToEditConfigurationOptions(environmentID)
+
+	recordRevision(cmd, environmentID)
+
+	model, err := environment.EditConfiguration(editConfigurationOptions)
+	if err != nil {
+		return editConfigurationOptions.HandleError(cmd, err)
+	}
+
+	if !editConfigurationOptions.WithDeploy {
+		return lib.FormatCommandData(cmd, model)
+	}
+
+	return deployCreated(cmd, createOptions, &editConfigurationOptions.DeployOptions, "updated", progressOptions)
+}
+
+func deployCreated(
+	cmd *cobra.Command,
+	createOptions *environment.CreateOptions,
+	deployOptions *environment.DeployOptions,
+	action string,
+	progressOptions *progress.Options,
+) error {
+	if createOptions.WatchOnlyOnFailure {
+		return HandleDeployOnFailure(cmd, deployOptions, createOptions.GetKubernetesIntegration(), progressOptions)
+	}
+
+	return HandleDeployWithProgress(
+		cmd,
+		deployOptions,
+		action,
+		createOptions.GetKubernetesIntegration(),
+		config.GetSettings().IsStylish(),
+		progressOptions,
+	)
+}
@@ -0,0 +1,55 @@
+package action
+
+import (
+	"bunnyshell.com/cli/pkg/api/environment"
+	"bunnyshell.com/cli/pkg/config"
+	"bunnyshell.com/cli/pkg/lib"
+	"bunnyshell.com/cli/pkg/util"
+	"github.com/spf13/cobra"
+)
+
+const definitionFilePerm = 0o644
+
+func newDefinitionDownloadCommand() *cobra.Command {
+	options := config.GetOptions()
+	settings := config.GetSettings()
+
+	definitionOptions := environment.NewDefinitionOptions("")
+	fileName := "bunnyshell.yaml"
+
+	command := &cobra.Command{
+		Use: "download",
+
+		Short: "Download the environment definition into a local file",
+
+		ValidArgsFunction: cobra.NoFileCompletions,
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			definitionOptions.ID = settings.Profile.Context.Environment
+
+			definition, err := environment.Definition(definitionOptions)
+			if err != nil {
+				return lib.FormatCommandError(cmd, err)
+			}
+
+			if err = util.WriteFileAtomic(fileName, definition.Bytes, definitionFilePerm); err != nil {
+				return err
+			}
+
+			if settings.IsStylish() {
+				cmd.Printf("Environment %s definition saved to %s\n", definitionOptions.ID, fileName)
+			}
+
+			return nil
+		},
+	}
+
+	flags := command.Flags()
+
+	flags.AddFlag(options.Environment.GetRequiredFlag("id"))
+
+	flags.StringVarP(&fileName, "file", "f", fileName, "File to write the definition to")
+	_ = command.MarkFlagFilename("file", "yaml", "yml")
+
+	return command
+}
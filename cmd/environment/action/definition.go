@@ -41,5 +41,10 @@ func init() {
 
 	flags.AddFlag(options.Environment.GetRequiredFlag("id"))
 
+	command.AddCommand(newDefinitionDownloadCommand())
+	command.AddCommand(newUpdateConfigurationCommand("update"))
+	command.AddCommand(newDefinitionHistoryCommand())
+	command.AddCommand(newDefinitionRollbackCommand())
+
 	mainCmd.AddCommand(command)
 }
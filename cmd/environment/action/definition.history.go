@@ -0,0 +1,114 @@
+package action
+
+import (
+	"bunnyshell.com/cli/pkg/api/environment"
+	"bunnyshell.com/cli/pkg/config"
+	environmentHistory "bunnyshell.com/cli/pkg/environment"
+	"bunnyshell.com/cli/pkg/lib"
+	"bunnyshell.com/sdk"
+	"github.com/spf13/cobra"
+)
+
+func newDefinitionHistoryCommand() *cobra.Command {
+	options := config.GetOptions()
+	settings := config.GetSettings()
+
+	command := &cobra.Command{
+		Use: "history",
+
+		Short: "List the definition revisions saved before each configuration update",
+		Long: "List the definition revisions saved before each configuration update.\n" +
+			"Revisions are only stored locally, in $HOME/.bunnyshell/history, so updates made " +
+			"from another machine or from the web interface are not listed.",
+
+		ValidArgsFunction: cobra.NoFileCompletions,
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			revisions, err := environmentHistory.ListRevisions(settings.Profile.Context.Environment)
+			if err != nil {
+				return lib.FormatCommandError(cmd, err)
+			}
+
+			return lib.FormatCommandData(cmd, revisions)
+		},
+	}
+
+	flags := command.Flags()
+
+	flags.AddFlag(options.Environment.GetRequiredFlag("id"))
+
+	return command
+}
+
+func newDefinitionRollbackCommand() *cobra.Command {
+	options := config.GetOptions()
+	settings := config.GetSettings()
+
+	editConfigurationOptions := environment.NewEditConfigurationOptions("")
+	revision := 0
+
+	command := &cobra.Command{
+		Use: "rollback",
+
+		Short: "Restore a saved definition revision",
+		Long: "Restore a definition revision saved on this machine, see the history command.\n" +
+			"Revisions are only stored locally, so only the updates made from this machine can be rolled back.",
+
+		ValidArgsFunction: cobra.NoFileCompletions,
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			editConfigurationOptions.ID = settings.Profile.Context.Environment
+
+			content, err := environmentHistory.ReadRevision(editConfigurationOptions.ID, revision)
+			if err != nil {
+				return err
+			}
+
+			// the rollback itself can be rolled back
+			recordRevision(cmd, editConfigurationOptions.ID)
+
+			yaml := string(content)
+			fromString := sdk.NewFromString()
+			fromString.Yaml = &yaml
+
+			editConfigurationOptions.Configuration = &sdk.EnvironmentEditConfigurationConfiguration{
+				FromString: fromString,
+			}
+
+			model, err := environment.EditConfiguration(editConfigurationOptions)
+			if err != nil {
+				return lib.FormatCommandError(cmd, err)
+			}
+
+			if !editConfigurationOptions.WithDeploy {
+				return lib.FormatCommandData(cmd, model)
+			}
+
+			deployOptions := &editConfigurationOptions.DeployOptions
+			deployOptions.ID = model.GetId()
+
+			return HandleDeploy(cmd, deployOptions, "rolled back", "", settings.IsStylish())
+		},
+	}
+
+	flags := command.Flags()
+
+	flags.AddFlag(options.Environment.GetRequiredFlag("id"))
+
+	flags.IntVar(&revision, "revision", revision, "Revision number to restore, see the definition history")
+	_ = command.MarkFlagRequired("revision")
+
+	flags.BoolVar(&editConfigurationOptions.WithDeploy, "deploy", editConfigurationOptions.WithDeploy, "Deploy the environment after the rollback")
+
+	editConfigurationOptions.DeployOptions.UpdateFlagSet(flags)
+
+	return command
+}
+
+// recordRevision saves the current definition before it is replaced, not being able to is not worth failing the update.
+// The warning is only shown in stylish mode, keeping the structured output a single document.
+func recordRevision(cmd *cobra.Command, environmentID string) {
+	if _, err := environmentHistory.RecordRevision(environmentID); err != nil && config.GetSettings().IsStylish() {
+		cmd.PrintErrf("Could not save the current definition of environment %s: %s\n", environmentID, err)
+	}
+}
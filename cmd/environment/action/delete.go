@@ -1,30 +1,83 @@
 package action
 
 import (
+	"errors"
+	"fmt"
+	"time"
+
 	"bunnyshell.com/cli/pkg/api/environment"
 	"bunnyshell.com/cli/pkg/config"
+	"bunnyshell.com/cli/pkg/config/enum"
+	environmentBulk "bunnyshell.com/cli/pkg/environment"
+	"bunnyshell.com/cli/pkg/interactive"
 	"bunnyshell.com/cli/pkg/lib"
+	"bunnyshell.com/sdk"
 	"github.com/spf13/cobra"
 )
 
+var (
+	errBulkFiltersRequireAll = errors.New("--label and --older-than require --all")
+	errConfirmationRequired  = errors.New("bulk deletion requires confirmation, use --yes in non-interactive mode")
+	errAllWithIDs            = errors.New("--all cannot be used with environment ids")
+	errEnvironmentProtected  = errors.New("environment is protected from deletion, run unprotect first or use --force")
+)
+
+type DeleteAllData struct {
+	All bool
+
+	Labels    map[string]string
+	OlderThan time.Duration
+
+	Yes bool
+}
+
 func init() {
 	options := config.GetOptions()
 	settings := config.GetSettings()
 
 	deleteOptions := environment.NewDeleteOptions("")
+	deleteAllData := DeleteAllData{}
+	force := false
 
 	command := &cobra.Command{
-		Use: "delete",
+		Use: "delete [environment-id...]",
 
 		ValidArgsFunction: cobra.NoFileCompletions,
 
 		PreRunE: func(cmd *cobra.Command, args []string) error {
-			return validateActionOptions(&deleteOptions.ActionOptions)
+			flags := cmd.Flags()
+
+			if deleteAllData.All && len(args) > 0 {
+				return errAllWithIDs
+			}
+
+			if !deleteAllData.All {
+				if flags.Changed("label") || flags.Changed("older-than") {
+					return errBulkFiltersRequireAll
+				}
+
+				// --id is only required when not deleting in bulk
+				requireIDWithoutArgs(cmd, args)
+			}
+
+			return ValidateActionOptions(&deleteOptions.ActionOptions)
 		},
 
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if deleteAllData.All {
+				return deleteAll(cmd, deleteAllData, force)
+			}
+
+			if len(args) > 0 {
+				return deleteIDs(cmd, args, deleteOptions, force)
+			}
+
 			deleteOptions.ID = settings.Profile.Context.Environment
 
+			if err := ensureDeletable(cmd, deleteOptions.ID, force); err != nil {
+				return err
+			}
+
 			event, err := environment.Delete(deleteOptions)
 			if err != nil {
 				return lib.FormatCommandError(cmd, err)
@@ -34,13 +87,19 @@ func init() {
 				return lib.FormatCommandData(cmd, event)
 			}
 
-			if err = processEventPipeline(cmd, event, "delete", settings.IsStylish()); err != nil {
-				cmd.Printf("\nEnvironment %s deletion failed\n", deleteOptions.ID)
+			printLogs := settings.IsStylish()
+
+			if err = processEventPipeline(cmd, event, "delete", printLogs, nil); err != nil {
+				if printLogs {
+					cmd.Printf("\nEnvironment %s deletion failed\n", deleteOptions.ID)
+				}
 
 				return err
 			}
 
-			cmd.Printf("\nEnvironment %s successfully deleted\n", deleteOptions.ID)
+			if printLogs {
+				cmd.Printf("\nEnvironment %s successfully deleted\n", deleteOptions.ID)
+			}
 
 			return nil
 		},
@@ -48,9 +107,129 @@ func init() {
 
 	flags := command.Flags()
 
-	flags.AddFlag(options.Environment.GetRequiredFlag("id"))
+	flags.AddFlag(options.Environment.GetFlag("id"))
 
 	deleteOptions.UpdateFlagSet(flags)
 
+	flags.AddFlag(options.Organization.GetFlag("organization"))
+	flags.AddFlag(options.Project.GetFlag("project"))
+
+	flags.BoolVar(&deleteAllData.All, "all", deleteAllData.All, "Delete all the environments matching the filters, without waiting for the pipelines")
+	flags.StringToStringVar(&deleteAllData.Labels, "label", deleteAllData.Labels, "Only delete environments with the label (key=value)")
+	flags.DurationVar(&deleteAllData.OlderThan, "older-than", deleteAllData.OlderThan, "Only delete environments created before the given duration")
+	flags.BoolVar(&deleteAllData.Yes, "yes", deleteAllData.Yes, "Skip the bulk deletion confirmation")
+	flags.BoolVar(&force, "force", force, "Remove the termination protection of the environment before deleting it")
+
+	command.MarkFlagsMutuallyExclusive("all", "no-wait")
+
 	mainCmd.AddCommand(command)
 }
+
+// ensureDeletable refuses protected environments, unless forced to remove the protection.
+func ensureDeletable(cmd *cobra.Command, environmentID string, force bool) error {
+	editSettingsOptions := environment.NewEditSettingsOptions(environmentID)
+
+	environmentModel, err := environment.Get(&editSettingsOptions.ItemOptions)
+	if err != nil {
+		return lib.FormatCommandError(cmd, err)
+	}
+
+	if !environmentModel.GetHasTerminationProtection() {
+		return nil
+	}
+
+	if !force {
+		return errEnvironmentProtected
+	}
+
+	if err = editSettingsOptions.UpdateEditSettingsForType(environmentModel.GetType()); err != nil {
+		return lib.FormatCommandError(cmd, err)
+	}
+
+	editSettingsOptions.TerminationProtection = enum.BoolFalse
+
+	if _, err = environment.EditSettings(editSettingsOptions); err != nil {
+		return lib.FormatCommandError(cmd, err)
+	}
+
+	if config.GetSettings().IsStylish() {
+		cmd.Printf("Environment %s termination protection removed\n", environmentID)
+	}
+
+	return nil
+}
+
+func deleteIDs(cmd *cobra.Command, environmentIDs []string, deleteOptions *environment.DeleteOptions, force bool) error {
+	for _, environmentID := range environmentIDs {
+		if err := ensureDeletable(cmd, environmentID, force); err != nil {
+			return fmt.Errorf("environment %s: %w", environmentID, err)
+		}
+	}
+
+	return runBulkAction(cmd, environmentIDs, !deleteOptions.WithoutPipeline, func(environmentID string) (*sdk.EventItem, error) {
+		environmentDeleteOptions := *deleteOptions
+		environmentDeleteOptions.ID = environmentID
+
+		return environment.Delete(&environmentDeleteOptions)
+	})
+}
+
+func deleteAll(cmd *cobra.Command, deleteAllData DeleteAllData, force bool) error {
+	settings := config.GetSettings()
+
+	listOptions := environment.NewListOptions()
+	listOptions.Organization = settings.Profile.Context.Organization
+	listOptions.Project = settings.Profile.Context.Project
+	listOptions.Labels = deleteAllData.Labels
+
+	environments, err := environment.ListAll(*listOptions)
+	if err != nil {
+		return lib.FormatCommandError(cmd, err)
+	}
+
+	environments, err = environmentBulk.SelectOlderThan(environments, deleteAllData.OlderThan, time.Now())
+	if err != nil {
+		return err
+	}
+
+	if len(environments) == 0 {
+		if settings.IsStylish() {
+			cmd.Println("No environments match the filters")
+		}
+
+		return nil
+	}
+
+	if !deleteAllData.Yes {
+		if settings.NonInteractive {
+			return errConfirmationRequired
+		}
+
+		for _, item := range environments {
+			cmd.Printf("%s (%s)\n", item.GetName(), item.GetId())
+		}
+
+		confirmed, err := interactive.Confirm(fmt.Sprintf("Delete %d environment(s)?", len(environments)))
+		if err != nil {
+			return err
+		}
+
+		if !confirmed {
+			return nil
+		}
+	}
+
+	environmentIDs := make([]string, 0, len(environments))
+
+	for _, item := range environments {
+		if err := ensureDeletable(cmd, item.GetId(), force); err != nil {
+			return fmt.Errorf("environment %s: %w", item.GetId(), err)
+		}
+
+		environmentIDs = append(environmentIDs, item.GetId())
+	}
+
+	return runBulkAction(cmd, environmentIDs, false, func(environmentID string) (*sdk.EventItem, error) {
+		return environment.Delete(environment.NewDeleteOptions(environmentID))
+	})
+}
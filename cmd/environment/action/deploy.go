@@ -1,8 +1,12 @@
 package action
 
 import (
+	"fmt"
+
 	"bunnyshell.com/cli/pkg/api/environment"
+	"bunnyshell.com/cli/pkg/api/k8s"
 	"bunnyshell.com/cli/pkg/config"
+	"bunnyshell.com/sdk"
 	"github.com/spf13/cobra"
 )
 
@@ -18,15 +22,21 @@ func init() {
 	deployData := DeployData{}
 
 	command := &cobra.Command{
-		Use: "deploy",
+		Use: "deploy [environment-id...]",
 
 		ValidArgsFunction: cobra.NoFileCompletions,
 
 		PreRunE: func(cmd *cobra.Command, args []string) error {
-			return validateActionOptions(&deployOptions.ActionOptions)
+			requireIDWithoutArgs(cmd, args)
+
+			return ValidateActionOptions(&deployOptions.ActionOptions)
 		},
 
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				return deployAll(cmd, args, deployOptions, deployData)
+			}
+
 			deployOptions.ID = settings.Profile.Context.Environment
 
 			return HandleDeploy(cmd, deployOptions, "", deployData.K8SIntegration, settings.IsStylish())
@@ -35,11 +45,32 @@ func init() {
 
 	flags := command.Flags()
 
-	flags.AddFlag(options.Environment.GetRequiredFlag("id"))
+	flags.AddFlag(options.Environment.GetFlag("id"))
 
 	deployOptions.UpdateFlagSet(flags)
 
 	flags.StringVar(&deployData.K8SIntegration, "k8s", deployData.K8SIntegration, "Use a Kubernetes integration for the deployment (if not set)")
 
+	k8s.RegisterFlagCompletion(command, "k8s")
+
 	mainCmd.AddCommand(command)
 }
+
+func deployAll(cmd *cobra.Command, environmentIDs []string, deployOptions *environment.DeployOptions, deployData DeployData) error {
+	// done upfront, the integration may have to be asked for
+	for _, environmentID := range environmentIDs {
+		environmentDeployOptions := *deployOptions
+		environmentDeployOptions.ID = environmentID
+
+		if err := ensureKubernetesIntegration(&environmentDeployOptions, deployData.K8SIntegration); err != nil {
+			return fmt.Errorf("environment %s: %w", environmentID, err)
+		}
+	}
+
+	return runBulkAction(cmd, environmentIDs, !deployOptions.WithoutPipeline, func(environmentID string) (*sdk.EventItem, error) {
+		environmentDeployOptions := *deployOptions
+		environmentDeployOptions.ID = environmentID
+
+		return environment.Deploy(&environmentDeployOptions)
+	})
+}
@@ -0,0 +1,67 @@
+package action
+
+import (
+	"errors"
+	"os"
+
+	"bunnyshell.com/cli/pkg/api/environment"
+	"bunnyshell.com/cli/pkg/config"
+	"bunnyshell.com/cli/pkg/lib"
+	"bunnyshell.com/cli/pkg/util"
+	"github.com/spf13/cobra"
+)
+
+var errDefinitionsDiffer = errors.New("local definition differs from the deployed one")
+
+func init() {
+	options := config.GetOptions()
+	settings := config.GetSettings()
+
+	definitionOptions := environment.NewDefinitionOptions("")
+	fileName := "bunnyshell.yaml"
+
+	command := &cobra.Command{
+		Use: "diff",
+
+		Short: "Compare a local definition file with the environment definition",
+		Long:  "Compare a local definition file with the environment definition.\nPrints a unified diff and exits with a non-zero code when they differ.",
+
+		ValidArgsFunction: cobra.NoFileCompletions,
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			local, err := os.ReadFile(fileName)
+			if err != nil {
+				return err
+			}
+
+			definitionOptions.ID = settings.Profile.Context.Environment
+
+			definition, err := environment.Definition(definitionOptions)
+			if err != nil {
+				return lib.FormatCommandError(cmd, err)
+			}
+
+			diff := util.UnifiedDiff("environment "+definitionOptions.ID, fileName, string(definition.Bytes), string(local))
+			if diff == "" {
+				if settings.IsStylish() {
+					cmd.Printf("Environment %s definition matches %s\n", definitionOptions.ID, fileName)
+				}
+
+				return nil
+			}
+
+			cmd.Print(diff)
+
+			return lib.NewExitCodeError(1, errDefinitionsDiffer)
+		},
+	}
+
+	flags := command.Flags()
+
+	flags.AddFlag(options.Environment.GetRequiredFlag("id"))
+
+	flags.StringVarP(&fileName, "file", "f", fileName, "Local definition file to compare")
+	_ = command.MarkFlagFilename("file", "yaml", "yml")
+
+	mainCmd.AddCommand(command)
+}
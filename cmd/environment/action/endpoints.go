@@ -1,22 +1,60 @@
 package action
 
 import (
+	"errors"
+	"fmt"
+
+	"bunnyshell.com/cli/pkg/api/component/endpoint"
 	"bunnyshell.com/cli/pkg/config"
+	"bunnyshell.com/cli/pkg/lib"
+	"bunnyshell.com/cli/pkg/util"
+	"bunnyshell.com/sdk"
 	"github.com/spf13/cobra"
 )
 
+var (
+	errNoEndpoints       = errors.New("environment has no defined public endpoints")
+	errComponentNotFound = errors.New("no public endpoints for component")
+)
+
 func init() {
 	options := config.GetOptions()
 	settings := config.GetSettings()
 
+	open := false
+	openComponent := ""
+
 	command := &cobra.Command{
 		Use:     "endpoints",
 		Aliases: []string{"end"},
 
+		Args: cobra.NoArgs,
+
 		ValidArgsFunction: cobra.NoFileCompletions,
 
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return showEnvironmentEndpoints(cmd, settings.Profile.Context.Environment)
+			if !open && openComponent == "" {
+				return showEnvironmentEndpoints(cmd, settings.Profile.Context.Environment)
+			}
+
+			aggregateOptions := endpoint.NewAggregateOptions()
+			aggregateOptions.Environment = settings.Profile.Context.Environment
+
+			components, err := endpoint.Aggregate(aggregateOptions)
+			if err != nil {
+				return lib.FormatCommandError(cmd, err)
+			}
+
+			url, err := findEndpoint(components, openComponent)
+			if err != nil {
+				return err
+			}
+
+			if settings.IsStylish() {
+				cmd.Printf("Opening %s\n", url)
+			}
+
+			return util.OpenURL(url)
 		},
 	}
 
@@ -24,5 +62,28 @@ func init() {
 
 	flags.AddFlag(options.Environment.GetRequiredFlag("id"))
 
+	flags.BoolVar(&open, "open", open, "Open the first endpoint in the default browser")
+	flags.StringVar(&openComponent, "open-component", openComponent, "Open the first endpoint of the named component in the default browser")
+	command.MarkFlagsMutuallyExclusive("open", "open-component")
+
 	mainCmd.AddCommand(command)
 }
+
+// findEndpoint returns the first endpoint of the named component, or of any component without a name.
+func findEndpoint(components []sdk.ComponentEndpointCollection, name string) (string, error) {
+	for _, component := range components {
+		if name != "" && component.GetName() != name {
+			continue
+		}
+
+		if endpoints := component.GetEndpoints(); len(endpoints) > 0 {
+			return endpoints[0], nil
+		}
+	}
+
+	if name == "" {
+		return "", errNoEndpoints
+	}
+
+	return "", fmt.Errorf("%w %s", errComponentNotFound, name)
+}
@@ -0,0 +1,41 @@
+package action
+
+import (
+	"bunnyshell.com/cli/pkg/api/environment"
+	"bunnyshell.com/cli/pkg/config"
+	"bunnyshell.com/cli/pkg/lib"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	options := config.GetOptions()
+	settings := config.GetSettings()
+
+	graphOptions := environment.NewGraphOptions("")
+
+	command := &cobra.Command{
+		Use: "graph",
+
+		Short: "Show the component dependency graph",
+		Long:  "Show the component dependency graph of an environment.\nThe stylish output is in DOT format, eg: graph --id EnvironmentID | dot -Tpng > graph.png",
+
+		ValidArgsFunction: cobra.NoFileCompletions,
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			graphOptions.ID = settings.Profile.Context.Environment
+
+			graph, err := environment.Graph(graphOptions)
+			if err != nil {
+				return lib.FormatCommandError(cmd, err)
+			}
+
+			return lib.FormatCommandData(cmd, graph)
+		},
+	}
+
+	flags := command.Flags()
+
+	flags.AddFlag(options.Environment.GetRequiredFlag("id"))
+
+	mainCmd.AddCommand(command)
+}
@@ -0,0 +1,90 @@
+package action
+
+import (
+	"strings"
+
+	"bunnyshell.com/cli/pkg/api/environment"
+	"bunnyshell.com/cli/pkg/config"
+	environmentNamespace "bunnyshell.com/cli/pkg/environment"
+	"bunnyshell.com/cli/pkg/k8s"
+	"bunnyshell.com/cli/pkg/lib"
+	"bunnyshell.com/cli/pkg/util"
+	"github.com/spf13/cobra"
+)
+
+// kubeconfigs hold credentials, keep them private to the user
+const kubeConfigFilePerm = 0o600
+
+func init() {
+	options := config.GetOptions()
+	settings := config.GetSettings()
+
+	kubeConfigOptions := environment.NewKubeConfigOptions("")
+
+	fileName := ""
+	merge := false
+	setCurrentContext := false
+
+	command := &cobra.Command{
+		Use: "kubeconfig",
+
+		Short: "Get a kubeconfig for the environment namespace",
+		Long:  "Get a kubeconfig for the environment namespace, its contexts defaulting to the namespace.\nPrints it by default, writes it with --file or merges it into the kubeconfig in use with --merge.",
+
+		ValidArgsFunction: cobra.NoFileCompletions,
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			kubeConfigOptions.ID = settings.Profile.Context.Environment
+
+			kubeConfig, err := environmentNamespace.GetKubeConfig(kubeConfigOptions)
+			if err != nil {
+				return lib.FormatCommandError(cmd, err)
+			}
+
+			if merge {
+				if fileName == "" {
+					fileName = k8s.DefaultKubeConfigPath()
+				}
+
+				contexts, err := k8s.MergeKubeConfig(fileName, kubeConfig, setCurrentContext)
+				if err != nil {
+					return err
+				}
+
+				if settings.IsStylish() {
+					cmd.Printf("Contexts %s merged into %s\n", strings.Join(contexts, ", "), fileName)
+				}
+
+				return nil
+			}
+
+			if fileName == "" {
+				cmd.Print(string(kubeConfig))
+
+				return nil
+			}
+
+			if err = util.WriteFileAtomic(fileName, kubeConfig, kubeConfigFilePerm); err != nil {
+				return err
+			}
+
+			if settings.IsStylish() {
+				cmd.Printf("Environment %s kubeconfig saved to %s\n", kubeConfigOptions.ID, fileName)
+			}
+
+			return nil
+		},
+	}
+
+	flags := command.Flags()
+
+	flags.AddFlag(options.Environment.GetRequiredFlag("id"))
+
+	flags.StringVarP(&fileName, "file", "f", fileName, "File to write the kubeconfig to (with --merge, defaults to the kubeconfig in use)")
+	flags.BoolVar(&merge, "merge", merge, "Merge the clusters, users and contexts into an existing kubeconfig")
+	flags.BoolVar(&setCurrentContext, "set-current-context", setCurrentContext, "Switch the current context to the environment when merging")
+
+	_ = command.MarkFlagFilename("file")
+
+	mainCmd.AddCommand(command)
+}
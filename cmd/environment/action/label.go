@@ -0,0 +1,163 @@
+package action
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"bunnyshell.com/cli/pkg/api/environment"
+	"bunnyshell.com/cli/pkg/config"
+	environmentLabels "bunnyshell.com/cli/pkg/environment"
+	"bunnyshell.com/cli/pkg/lib"
+	"github.com/spf13/cobra"
+)
+
+var errInvalidLabel = errors.New("labels must be provided as key=value")
+
+func init() {
+	command := &cobra.Command{
+		Use: "label",
+
+		Short: "Manage the environment labels",
+	}
+
+	command.AddCommand(newLabelListCommand())
+	command.AddCommand(newLabelAddCommand())
+	command.AddCommand(newLabelRemoveCommand())
+
+	mainCmd.AddCommand(command)
+}
+
+func newLabelListCommand() *cobra.Command {
+	options := config.GetOptions()
+	settings := config.GetSettings()
+
+	itemOptions := environment.NewItemOptions("")
+
+	command := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+
+		ValidArgsFunction: cobra.NoFileCompletions,
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			itemOptions.ID = settings.Profile.Context.Environment
+
+			model, err := environment.Get(itemOptions)
+			if err != nil {
+				return lib.FormatCommandError(cmd, err)
+			}
+
+			return lib.FormatCommandData(cmd, environmentLabels.Labels(model.GetLabels()))
+		},
+	}
+
+	flags := command.Flags()
+
+	flags.AddFlag(options.Environment.GetRequiredFlag("id"))
+
+	return command
+}
+
+func newLabelAddCommand() *cobra.Command {
+	options := config.GetOptions()
+	settings := config.GetSettings()
+
+	command := &cobra.Command{
+		Use:     "add <key=value>...",
+		Aliases: []string{"set"},
+
+		Short: "Add or update environment labels",
+
+		Args: cobra.MinimumNArgs(1),
+
+		ValidArgsFunction: cobra.NoFileCompletions,
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			labels := map[string]string{}
+
+			for _, pair := range args {
+				key, value, found := strings.Cut(pair, "=")
+				if !found || key == "" {
+					return fmt.Errorf("%w: %s", errInvalidLabel, pair)
+				}
+
+				labels[key] = value
+			}
+
+			return editLabels(cmd, settings.Profile.Context.Environment, func(map[string]string) (map[string]string, bool) {
+				return labels, false
+			})
+		},
+	}
+
+	flags := command.Flags()
+
+	flags.AddFlag(options.Environment.GetRequiredFlag("id"))
+
+	return command
+}
+
+func newLabelRemoveCommand() *cobra.Command {
+	options := config.GetOptions()
+	settings := config.GetSettings()
+
+	command := &cobra.Command{
+		Use:     "remove <key>...",
+		Aliases: []string{"rm"},
+
+		Short: "Remove environment labels",
+
+		Args: cobra.MinimumNArgs(1),
+
+		ValidArgsFunction: cobra.NoFileCompletions,
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return editLabels(cmd, settings.Profile.Context.Environment, func(current map[string]string) (map[string]string, bool) {
+				labels := map[string]string{}
+				for key, value := range current {
+					labels[key] = value
+				}
+
+				for _, key := range args {
+					delete(labels, key)
+				}
+
+				// the API merges labels, removing keys requires replacing all of them
+				return labels, true
+			})
+		},
+	}
+
+	flags := command.Flags()
+
+	flags.AddFlag(options.Environment.GetRequiredFlag("id"))
+
+	return command
+}
+
+func editLabels(
+	cmd *cobra.Command,
+	environmentID string,
+	change func(current map[string]string) (map[string]string, bool),
+) error {
+	editSettingsOptions := environment.NewEditSettingsOptions(environmentID)
+
+	environmentModel, err := environment.Get(&editSettingsOptions.ItemOptions)
+	if err != nil {
+		return lib.FormatCommandError(cmd, err)
+	}
+
+	if err = editSettingsOptions.UpdateEditSettingsForType(environmentModel.GetType()); err != nil {
+		return lib.FormatCommandError(cmd, err)
+	}
+
+	editSettingsOptions.Labels, editSettingsOptions.LabelReplace = change(environmentModel.GetLabels())
+
+	model, err := environment.EditSettings(editSettingsOptions)
+	if err != nil {
+		return lib.FormatCommandError(cmd, err)
+	}
+
+	return lib.FormatCommandData(cmd, environmentLabels.Labels(model.GetLabels()))
+}
@@ -0,0 +1,52 @@
+package action
+
+import (
+	"os"
+	"os/signal"
+
+	"bunnyshell.com/cli/pkg/config"
+	environmentLogs "bunnyshell.com/cli/pkg/environment"
+	"bunnyshell.com/cli/pkg/lib"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	options := config.GetOptions()
+	settings := config.GetSettings()
+
+	logsOptions := environmentLogs.LogsOptions{
+		Tail: -1,
+	}
+
+	command := &cobra.Command{
+		Use: "logs",
+
+		Short: "Show the container logs of all the environment components",
+		Long:  "Show the container logs of all the environment components.\nEach line is prefixed with its component, along with the pod and container when there are several.",
+
+		ValidArgsFunction: cobra.NoFileCompletions,
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt)
+			defer stop()
+
+			err := environmentLogs.StreamLogs(ctx, settings.Profile.Context.Environment, logsOptions, cmd.OutOrStdout())
+			if err != nil {
+				return lib.FormatCommandError(cmd, err)
+			}
+
+			return nil
+		},
+	}
+
+	flags := command.Flags()
+
+	flags.AddFlag(options.Environment.GetRequiredFlag("id"))
+
+	flags.StringArrayVar(&logsOptions.Components, "component", logsOptions.Components, "Only show the logs of the named component")
+	flags.BoolVarP(&logsOptions.Follow, "follow", "f", logsOptions.Follow, "Keep streaming new log lines")
+	flags.Int64Var(&logsOptions.Tail, "tail", logsOptions.Tail, "Number of lines to show from the end of the logs, -1 shows all")
+	flags.DurationVar(&logsOptions.Since, "since", logsOptions.Since, "Only show the logs newer than the given duration")
+
+	mainCmd.AddCommand(command)
+}
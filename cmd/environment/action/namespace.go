@@ -0,0 +1,60 @@
+package action
+
+import (
+	"errors"
+
+	"bunnyshell.com/cli/pkg/config"
+	environmentNamespace "bunnyshell.com/cli/pkg/environment"
+	"bunnyshell.com/cli/pkg/k8s"
+	"bunnyshell.com/cli/pkg/lib"
+	"github.com/spf13/cobra"
+)
+
+var errNoKubernetesIntegration = errors.New("the environment has no Kubernetes integration yet")
+
+func init() {
+	options := config.GetOptions()
+	settings := config.GetSettings()
+
+	setKubectlContext := false
+
+	command := &cobra.Command{
+		Use: "namespace",
+
+		Short: "Show the Kubernetes namespace, cluster and integration of the environment",
+
+		ValidArgsFunction: cobra.NoFileCompletions,
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			info, err := environmentNamespace.GetNamespaceInfo(settings.Profile.Context.Environment)
+			if err != nil {
+				return lib.FormatCommandError(cmd, err)
+			}
+
+			if setKubectlContext {
+				if info.KubernetesIntegration == "" {
+					return errNoKubernetesIntegration
+				}
+
+				path := k8s.DefaultKubeConfigPath()
+				if err = info.SetKubectlContext(path); err != nil {
+					return err
+				}
+
+				if settings.IsStylish() {
+					cmd.Printf("Switched to context %s in %s, using namespace %s\n\n", info.Context, path, info.Namespace)
+				}
+			}
+
+			return lib.FormatCommandData(cmd, info)
+		},
+	}
+
+	flags := command.Flags()
+
+	flags.AddFlag(options.Environment.GetRequiredFlag("id"))
+
+	flags.BoolVar(&setKubectlContext, "set-kubectl-context", setKubectlContext, "Merge the environment kubeconfig and switch kubectl to its context and namespace")
+
+	mainCmd.AddCommand(command)
+}
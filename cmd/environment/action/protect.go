@@ -0,0 +1,29 @@
+package action
+
+import (
+	"errors"
+
+	"bunnyshell.com/cli/pkg/api/environment"
+	"bunnyshell.com/cli/pkg/config/enum"
+	"bunnyshell.com/sdk"
+	"github.com/spf13/cobra"
+)
+
+var errProtectionRequiresPrimary = errors.New("termination protection is only available for primary environments")
+
+func init() {
+	mainCmd.AddCommand(newProtectCommand("protect", "Prevent the environment from being deleted", enum.BoolTrue))
+	mainCmd.AddCommand(newProtectCommand("unprotect", "Allow the environment to be deleted", enum.BoolFalse))
+}
+
+func newProtectCommand(use string, short string, protection enum.Bool) *cobra.Command {
+	return newSettingsToggleCommand(use, short, func(editSettingsOptions *environment.EditSettingsOptions, environmentModel *sdk.EnvironmentItem) error {
+		if environmentModel.GetType() != "primary" {
+			return errProtectionRequiresPrimary
+		}
+
+		editSettingsOptions.TerminationProtection = protection
+
+		return nil
+	})
+}
@@ -0,0 +1,82 @@
+package action
+
+import (
+	"bunnyshell.com/cli/pkg/api/environment"
+	"bunnyshell.com/cli/pkg/config"
+	"bunnyshell.com/cli/pkg/lib"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	options := config.GetOptions()
+	settings := config.GetSettings()
+
+	startOptions := environment.NewStartOptions("")
+
+	command := &cobra.Command{
+		Use: "restart",
+
+		Short: "Stop and start the environment",
+		Long:  "Stop and start the environment, waiting for it to stop first.\nWith --no-wait only the start pipeline is not followed.",
+
+		ValidArgsFunction: cobra.NoFileCompletions,
+
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return ValidateActionOptions(&startOptions.ActionOptions)
+		},
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			startOptions.ID = settings.Profile.Context.Environment
+
+			printLogs := settings.IsStylish()
+
+			// the start is only accepted once the environment is stopped
+			stopOptions := environment.NewStopOptions(startOptions.ID)
+			stopOptions.UseComponentsOf(&startOptions.PartialActionOptions)
+
+			event, err := environment.Stop(stopOptions)
+			if err != nil {
+				return lib.FormatCommandError(cmd, err)
+			}
+
+			if err = processEventPipeline(cmd, event, "stop", printLogs, nil); err != nil {
+				if printLogs {
+					cmd.Printf("\nEnvironment %s stopping failed\n", startOptions.ID)
+				}
+
+				return err
+			}
+
+			event, err = environment.Start(startOptions)
+			if err != nil {
+				return lib.FormatCommandError(cmd, err)
+			}
+
+			if startOptions.WithoutPipeline {
+				return lib.FormatCommandData(cmd, event)
+			}
+
+			if err = processEventPipeline(cmd, event, "start", printLogs, nil); err != nil {
+				if printLogs {
+					cmd.Printf("\nEnvironment %s starting failed\n", startOptions.ID)
+				}
+
+				return err
+			}
+
+			if printLogs {
+				cmd.Printf("\nEnvironment %s successfully restarted\n", startOptions.ID)
+			}
+
+			return showEnvironmentEndpoints(cmd, startOptions.ID)
+		},
+	}
+
+	flags := command.Flags()
+
+	flags.AddFlag(options.Environment.GetRequiredFlag("id"))
+
+	startOptions.UpdateFlagSet(flags)
+
+	mainCmd.AddCommand(command)
+}
@@ -3,10 +3,12 @@ package action
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"bunnyshell.com/cli/pkg/api/common"
 	"bunnyshell.com/cli/pkg/api/component/endpoint"
 	"bunnyshell.com/cli/pkg/api/environment"
+	"bunnyshell.com/cli/pkg/api/pipeline"
 	"bunnyshell.com/cli/pkg/build"
 	"bunnyshell.com/cli/pkg/config"
 	"bunnyshell.com/cli/pkg/interactive"
@@ -28,7 +30,8 @@ func GetMainCommand() *cobra.Command {
 	return mainCmd
 }
 
-func validateActionOptions(actionOptions *common.ActionOptions) error {
+// ValidateActionOptions checks the pipeline options against the output format.
+func ValidateActionOptions(actionOptions *common.ActionOptions) error {
 	if !actionOptions.WithoutPipeline {
 		return nil
 	}
@@ -41,6 +44,18 @@ func validateActionOptions(actionOptions *common.ActionOptions) error {
 }
 
 func HandleDeploy(cmd *cobra.Command, deployOptions *environment.DeployOptions, action string, kubernetesIntegration string, printLogs bool) error {
+	return HandleDeployWithProgress(cmd, deployOptions, action, kubernetesIntegration, printLogs, nil)
+}
+
+// HandleDeployWithProgress deploys like HandleDeploy, waiting for the pipeline with the given progress options.
+func HandleDeployWithProgress(
+	cmd *cobra.Command,
+	deployOptions *environment.DeployOptions,
+	action string,
+	kubernetesIntegration string,
+	printLogs bool,
+	progressOptions *progress.Options,
+) error {
 	if err := ensureKubernetesIntegration(deployOptions, kubernetesIntegration); err != nil {
 		return err
 	}
@@ -58,7 +73,7 @@ func HandleDeploy(cmd *cobra.Command, deployOptions *environment.DeployOptions,
 		return lib.FormatCommandData(cmd, event)
 	}
 
-	if err = processEventPipeline(cmd, event, "deploy", printLogs); err != nil {
+	if err = processEventPipeline(cmd, event, "deploy", printLogs, progressOptions); err != nil {
 		if printLogs {
 			cmd.Printf("\nEnvironment %s deploying failed\n", deployOptions.ID)
 		}
@@ -73,6 +88,64 @@ func HandleDeploy(cmd *cobra.Command, deployOptions *environment.DeployOptions,
 	return showEnvironmentEndpoints(cmd, deployOptions.ID)
 }
 
+// HandleDeployOnFailure deploys without showing progress, printing the pipeline stages only when the deployment fails.
+// The job logs are not available through the API, the pipeline link leads to them.
+func HandleDeployOnFailure(cmd *cobra.Command, deployOptions *environment.DeployOptions, kubernetesIntegration string, progressOptions *progress.Options) error {
+	if err := ensureKubernetesIntegration(deployOptions, kubernetesIntegration); err != nil {
+		return err
+	}
+
+	event, err := environment.Deploy(deployOptions)
+	if err != nil {
+		return lib.FormatCommandError(cmd, err)
+	}
+
+	progressOptions.Silent = true
+
+	pipelineItem, err := progress.EventToPipeline(event, progressOptions)
+	if err != nil {
+		return err
+	}
+
+	if err = progress.Pipeline(pipelineItem.GetId(), progressOptions); err != nil {
+		printFailedPipeline(cmd, pipelineItem.GetId())
+
+		return err
+	}
+
+	return showEnvironmentEndpoints(cmd, deployOptions.ID)
+}
+
+func printFailedPipeline(cmd *cobra.Command, pipelineID string) {
+	model, err := pipeline.Get(pipeline.NewItemOptions(pipelineID))
+	if err != nil {
+		cmd.PrintErrf("Could not fetch pipeline %s: %s\n", pipelineID, err)
+
+		return
+	}
+
+	cmd.PrintErrf("\nEnvironment %s deploying failed in pipeline %s\n", model.GetEnvironment(), model.GetId())
+
+	for _, stage := range model.GetStages() {
+		if stage.GetStatus() == progress.StatusSuccess {
+			continue
+		}
+
+		cmd.PrintErrf(
+			"Stage %s: %s, %d/%d jobs completed in %s\n",
+			stage.GetName(),
+			stage.GetStatus(),
+			stage.GetCompletedJobsCount(),
+			stage.GetJobsCount(),
+			time.Duration(stage.GetDuration())*time.Second,
+		)
+	}
+
+	if model.GetWebUrl() != "" {
+		cmd.PrintErrf("\nPipeline details: %s\n", model.GetWebUrl())
+	}
+}
+
 func ensureKubernetesIntegration(deployOptions *environment.DeployOptions, kubernetesIntegration string) error {
 	model, err := environment.Get(environment.NewItemOptions(deployOptions.ID))
 	if err != nil {
@@ -120,8 +193,10 @@ func ensureKubernetesIntegration(deployOptions *environment.DeployOptions, kuber
 	return err
 }
 
-func processEventPipeline(cmd *cobra.Command, event *sdk.EventItem, action string, printLogs bool) error {
-	progressOptions := progress.NewOptions()
+func processEventPipeline(cmd *cobra.Command, event *sdk.EventItem, action string, printLogs bool, progressOptions *progress.Options) error {
+	if progressOptions == nil {
+		progressOptions = progress.NewOptions()
+	}
 
 	if printLogs {
 		cmd.Printf(
@@ -150,13 +225,24 @@ func processEventPipeline(cmd *cobra.Command, event *sdk.EventItem, action strin
 		}
 	}
 
-	if err = progress.Pipeline(pipeline.GetId(), nil); err != nil {
+	if err = progress.Pipeline(pipeline.GetId(), progressOptions); err != nil {
 		return err
 	}
 
 	return nil
 }
 
+// printComposeSkipped only warns in stylish mode, keeping the structured output a single document.
+func printComposeSkipped(cmd *cobra.Command, skipped []string) {
+	if !config.GetSettings().IsStylish() {
+		return
+	}
+
+	for _, construct := range skipped {
+		cmd.PrintErrf("Skipped unsupported compose construct: %s\n", construct)
+	}
+}
+
 func showEnvironmentEndpoints(cmd *cobra.Command, environment string) error {
 	options := endpoint.NewAggregateOptions()
 	options.Environment = environment
@@ -0,0 +1,115 @@
+package action
+
+import (
+	"errors"
+
+	"bunnyshell.com/cli/pkg/api/environment"
+	"bunnyshell.com/cli/pkg/config"
+	githelper "bunnyshell.com/cli/pkg/helper/git"
+	"bunnyshell.com/cli/pkg/lib"
+	"bunnyshell.com/sdk"
+	"github.com/spf13/cobra"
+)
+
+var errGitSourceRequired = errors.New("--git-repo, --git-branch and --git-path are required, unless --from-current-repo is given")
+
+func init() {
+	options := config.GetOptions()
+	settings := config.GetSettings()
+
+	editConfigurationOptions := environment.NewEditConfigurationOptions("")
+	gitRepo, gitBranch, gitPath := "", "", ""
+	fromCurrentRepo := false
+
+	command := &cobra.Command{
+		Use: "set-source",
+
+		Short: "Point the environment at another git repository, branch or definition path",
+		Long: "Point the environment at another git repository, branch or definition path.\n" +
+			"With --from-current-repo, the values not given are taken from the git checkout of the current directory, " +
+			"so changing the branch only needs --git-branch when run from the repository.",
+
+		ValidArgsFunction: cobra.NoFileCompletions,
+
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if !fromCurrentRepo && (gitRepo == "" || gitBranch == "" || gitPath == "") {
+				return errGitSourceRequired
+			}
+
+			return nil
+		},
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			editConfigurationOptions.ID = settings.Profile.Context.Environment
+
+			fromGit, err := getSourceFromGit(gitRepo, gitBranch, gitPath)
+			if err != nil {
+				return err
+			}
+
+			recordRevision(cmd, editConfigurationOptions.ID)
+
+			editConfigurationOptions.Configuration = &sdk.EnvironmentEditConfigurationConfiguration{
+				FromGit: fromGit,
+			}
+
+			model, err := environment.EditConfiguration(editConfigurationOptions)
+			if err != nil {
+				return editConfigurationOptions.HandleError(cmd, err)
+			}
+
+			if !editConfigurationOptions.WithDeploy {
+				return lib.FormatCommandData(cmd, model)
+			}
+
+			deployOptions := &editConfigurationOptions.DeployOptions
+			deployOptions.ID = model.GetId()
+
+			return HandleDeploy(cmd, deployOptions, "updated", "", settings.IsStylish())
+		},
+	}
+
+	flags := command.Flags()
+
+	flags.AddFlag(options.Environment.GetRequiredFlag("id"))
+
+	flags.StringVar(&gitRepo, "git-repo", gitRepo, "Git repository of the environment definition")
+	flags.StringVar(&gitBranch, "git-branch", gitBranch, "Git branch of the environment definition")
+	flags.StringVar(&gitPath, "git-path", gitPath, "Path of the environment definition within the repository")
+	flags.BoolVar(&fromCurrentRepo, "from-current-repo", fromCurrentRepo, "Take the git values not given from the checkout of the current directory")
+
+	flags.BoolVar(&editConfigurationOptions.WithDeploy, "deploy", editConfigurationOptions.WithDeploy, "Deploy the environment after the update")
+
+	editConfigurationOptions.DeployOptions.UpdateFlagSet(flags)
+
+	mainCmd.AddCommand(command)
+}
+
+// getSourceFromGit fills the missing values from the current checkout, the caller ensuring it was asked for.
+func getSourceFromGit(gitRepo string, gitBranch string, gitPath string) (*sdk.FromGit, error) {
+	if gitRepo == "" || gitBranch == "" || gitPath == "" {
+		repository, err := githelper.DetectLocalRepository(".")
+		if err != nil {
+			return nil, err
+		}
+
+		if gitRepo == "" {
+			gitRepo = repository.URL
+		}
+
+		if gitBranch == "" {
+			gitBranch = repository.Branch
+		}
+
+		if gitPath == "" {
+			gitPath = repository.YamlPath
+		}
+	}
+
+	fromGit := sdk.NewFromGit()
+	fromGit.Url = &gitRepo
+	fromGit.Branch = &gitBranch
+	fromGit.YamlPath = &gitPath
+
+	return fromGit, nil
+}
@@ -0,0 +1,57 @@
+package action
+
+import (
+	"bunnyshell.com/cli/pkg/api/environment"
+	"bunnyshell.com/cli/pkg/config"
+	"bunnyshell.com/cli/pkg/lib"
+	"bunnyshell.com/sdk"
+	"github.com/spf13/cobra"
+)
+
+// newSettingsToggleCommand builds commands changing a single environment setting, validated against the environment first.
+func newSettingsToggleCommand(
+	use string,
+	short string,
+	apply func(editSettingsOptions *environment.EditSettingsOptions, environmentModel *sdk.EnvironmentItem) error,
+) *cobra.Command {
+	options := config.GetOptions()
+	settings := config.GetSettings()
+
+	command := &cobra.Command{
+		Use: use,
+
+		Short: short,
+
+		ValidArgsFunction: cobra.NoFileCompletions,
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			editSettingsOptions := environment.NewEditSettingsOptions(settings.Profile.Context.Environment)
+
+			environmentModel, err := environment.Get(&editSettingsOptions.ItemOptions)
+			if err != nil {
+				return lib.FormatCommandError(cmd, err)
+			}
+
+			if err = editSettingsOptions.UpdateEditSettingsForType(environmentModel.GetType()); err != nil {
+				return lib.FormatCommandError(cmd, err)
+			}
+
+			if err = apply(editSettingsOptions, environmentModel); err != nil {
+				return err
+			}
+
+			model, err := environment.EditSettings(editSettingsOptions)
+			if err != nil {
+				return lib.FormatCommandError(cmd, err)
+			}
+
+			return lib.FormatCommandData(cmd, model)
+		},
+	}
+
+	flags := command.Flags()
+
+	flags.AddFlag(options.Environment.GetRequiredFlag("id"))
+
+	return command
+}
@@ -4,6 +4,7 @@ import (
 	"bunnyshell.com/cli/pkg/api/environment"
 	"bunnyshell.com/cli/pkg/config"
 	"bunnyshell.com/cli/pkg/lib"
+	"bunnyshell.com/sdk"
 	"github.com/spf13/cobra"
 )
 
@@ -14,15 +15,26 @@ func init() {
 	startOptions := environment.NewStartOptions("")
 
 	command := &cobra.Command{
-		Use: "start",
+		Use: "start [environment-id...]",
 
 		ValidArgsFunction: cobra.NoFileCompletions,
 
 		PreRunE: func(cmd *cobra.Command, args []string) error {
-			return validateActionOptions(&startOptions.ActionOptions)
+			requireIDWithoutArgs(cmd, args)
+
+			return ValidateActionOptions(&startOptions.ActionOptions)
 		},
 
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				return runBulkAction(cmd, args, !startOptions.WithoutPipeline, func(environmentID string) (*sdk.EventItem, error) {
+					environmentStartOptions := *startOptions
+					environmentStartOptions.ID = environmentID
+
+					return environment.Start(&environmentStartOptions)
+				})
+			}
+
 			startOptions.ID = settings.Profile.Context.Environment
 
 			event, err := environment.Start(startOptions)
@@ -36,7 +48,7 @@ func init() {
 
 			printLogs := settings.IsStylish()
 
-			if err = processEventPipeline(cmd, event, "start", printLogs); err != nil {
+			if err = processEventPipeline(cmd, event, "start", printLogs, nil); err != nil {
 				if printLogs {
 					cmd.Printf("\nEnvironment %s starting failed\n", startOptions.ID)
 				}
@@ -54,7 +66,7 @@ func init() {
 
 	flags := command.Flags()
 
-	flags.AddFlag(options.Environment.GetRequiredFlag("id"))
+	flags.AddFlag(options.Environment.GetFlag("id"))
 
 	startOptions.UpdateFlagSet(flags)
 
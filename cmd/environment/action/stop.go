@@ -4,6 +4,7 @@ import (
 	"bunnyshell.com/cli/pkg/api/environment"
 	"bunnyshell.com/cli/pkg/config"
 	"bunnyshell.com/cli/pkg/lib"
+	"bunnyshell.com/sdk"
 	"github.com/spf13/cobra"
 )
 
@@ -14,15 +15,26 @@ func init() {
 	stopOptions := environment.NewStopOptions("")
 
 	command := &cobra.Command{
-		Use: "stop",
+		Use: "stop [environment-id...]",
 
 		ValidArgsFunction: cobra.NoFileCompletions,
 
 		PreRunE: func(cmd *cobra.Command, args []string) error {
-			return validateActionOptions(&stopOptions.ActionOptions)
+			requireIDWithoutArgs(cmd, args)
+
+			return ValidateActionOptions(&stopOptions.ActionOptions)
 		},
 
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				return runBulkAction(cmd, args, !stopOptions.WithoutPipeline, func(environmentID string) (*sdk.EventItem, error) {
+					environmentStopOptions := *stopOptions
+					environmentStopOptions.ID = environmentID
+
+					return environment.Stop(&environmentStopOptions)
+				})
+			}
+
 			stopOptions.ID = settings.Profile.Context.Environment
 
 			event, err := environment.Stop(stopOptions)
@@ -36,7 +48,7 @@ func init() {
 
 			printLogs := settings.IsStylish()
 
-			if err = processEventPipeline(cmd, event, "stop", printLogs); err != nil {
+			if err = processEventPipeline(cmd, event, "stop", printLogs, nil); err != nil {
 				if printLogs {
 					cmd.Printf("\nEnvironment %s stopping failed\n", stopOptions.ID)
 				}
@@ -54,7 +66,7 @@ func init() {
 
 	flags := command.Flags()
 
-	flags.AddFlag(options.Environment.GetRequiredFlag("id"))
+	flags.AddFlag(options.Environment.GetFlag("id"))
 
 	stopOptions.UpdateFlagSet(flags)
 
@@ -3,6 +3,7 @@ package action
 import (
 	"bunnyshell.com/cli/pkg/api/build_settings"
 	"bunnyshell.com/cli/pkg/api/environment"
+	"bunnyshell.com/cli/pkg/api/k8s"
 	"bunnyshell.com/cli/pkg/config"
 	"bunnyshell.com/cli/pkg/config/enum"
 	"bunnyshell.com/cli/pkg/lib"
@@ -80,6 +81,8 @@ func init() {
 	command.MarkFlagsMutuallyExclusive("use-project-registry", "use-managed-registry")
 	command.MarkFlagsMutuallyExclusive("use-project-registry", "registry")
 
+	k8s.RegisterFlagCompletion(command, "k8s")
+
 	mainCmd.AddCommand(command)
 }
 
@@ -6,6 +6,7 @@ import (
 
 	"bunnyshell.com/cli/pkg/api/component/git"
 	"bunnyshell.com/cli/pkg/api/environment"
+	"bunnyshell.com/cli/pkg/api/k8s"
 	"bunnyshell.com/cli/pkg/build"
 	"bunnyshell.com/cli/pkg/config"
 	githelper "bunnyshell.com/cli/pkg/helper/git"
@@ -118,6 +119,8 @@ func init() {
 	flags.StringVar(&editSource.Component, "component-name", editSource.Component, "Filter by component name")
 	command.MarkFlagsMutuallyExclusive("git-source", "component-name")
 
+	k8s.RegisterFlagCompletion(command, "k8s")
+
 	mainCmd.AddCommand(command)
 }
 
@@ -8,13 +8,17 @@ import (
 )
 
 func init() {
+	mainCmd.AddCommand(newUpdateConfigurationCommand("update-configuration"))
+}
+
+func newUpdateConfigurationCommand(use string) *cobra.Command {
 	options := config.GetOptions()
 	settings := config.GetSettings()
 
 	editConfigurationOptions := environment.NewEditConfigurationOptions("")
 
 	command := &cobra.Command{
-		Use: "update-configuration",
+		Use: use,
 
 		ValidArgsFunction: cobra.NoFileCompletions,
 
@@ -29,6 +33,10 @@ func init() {
 				return lib.FormatCommandError(cmd, err)
 			}
 
+			printComposeSkipped(cmd, editConfigurationOptions.ComposeSkipped())
+
+			recordRevision(cmd, editConfigurationOptions.ID)
+
 			model, err := environment.EditConfiguration(editConfigurationOptions)
 			if err != nil {
 				return editConfigurationOptions.HandleError(cmd, err)
@@ -53,5 +61,5 @@ func init() {
 
 	editConfigurationOptions.UpdateCommandFlags(command)
 
-	mainCmd.AddCommand(command)
+	return command
 }
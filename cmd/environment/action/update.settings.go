@@ -2,6 +2,7 @@ package action
 
 import (
 	"bunnyshell.com/cli/pkg/api/environment"
+	"bunnyshell.com/cli/pkg/api/k8s"
 	"bunnyshell.com/cli/pkg/config"
 	"bunnyshell.com/cli/pkg/lib"
 	"bunnyshell.com/cli/pkg/util"
@@ -15,7 +16,8 @@ func init() {
 	editSettingsOptions := environment.NewEditSettingsOptions("")
 
 	command := &cobra.Command{
-		Use: "update-settings",
+		Use:     "update-settings",
+		Aliases: []string{"edit"},
 
 		ValidArgsFunction: cobra.NoFileCompletions,
 
@@ -26,7 +28,10 @@ func init() {
 			if err != nil {
 				return lib.FormatCommandError(cmd, err)
 			}
-			editSettingsOptions.UpdateEditSettingsForType(environmentModel.GetType())
+
+			if err = editSettingsOptions.UpdateEditSettingsForType(environmentModel.GetType()); err != nil {
+				return lib.FormatCommandError(cmd, err)
+			}
 
 			model, err := environment.EditSettings(editSettingsOptions)
 			if err != nil {
@@ -43,5 +48,7 @@ func init() {
 
 	editSettingsOptions.UpdateFlagSet(flags)
 
+	k8s.RegisterFlagCompletion(command, "k8s", "ephemerals-k8s")
+
 	mainCmd.AddCommand(command)
 }
@@ -0,0 +1,51 @@
+package action
+
+import (
+	"errors"
+	"os"
+
+	"bunnyshell.com/cli/pkg/environment"
+	"bunnyshell.com/cli/pkg/lib"
+	"github.com/spf13/cobra"
+)
+
+var errInvalidDefinition = errors.New("environment definition is not valid")
+
+func init() {
+	fileName := "bunnyshell.yaml"
+
+	command := &cobra.Command{
+		Use: "validate",
+
+		Short: "Validate a local environment definition file",
+		Long:  "Validate a local environment definition file.\nChecks the YAML syntax, the environment attributes, the components and the variables, reporting each violation with its line.",
+
+		ValidArgsFunction: cobra.NoFileCompletions,
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			content, err := os.ReadFile(fileName)
+			if err != nil {
+				return err
+			}
+
+			report := environment.ValidateDefinition(content)
+
+			if err = lib.FormatCommandData(cmd, report); err != nil {
+				return err
+			}
+
+			if !report.IsValid() {
+				return lib.NewExitCodeError(1, errInvalidDefinition)
+			}
+
+			return nil
+		},
+	}
+
+	flags := command.Flags()
+
+	flags.StringVarP(&fileName, "file", "f", fileName, "Definition file to validate")
+	_ = command.MarkFlagFilename("file", "yaml", "yml")
+
+	mainCmd.AddCommand(command)
+}
@@ -0,0 +1,107 @@
+package action
+
+import (
+	"errors"
+
+	"bunnyshell.com/cli/pkg/config"
+	environmentWait "bunnyshell.com/cli/pkg/environment"
+	"bunnyshell.com/cli/pkg/lib"
+	"github.com/spf13/cobra"
+)
+
+const (
+	waitFailedExitCode  = 2
+	waitTimeoutExitCode = 3
+)
+
+func init() {
+	options := config.GetOptions()
+	settings := config.GetSettings()
+
+	condition := ""
+	waitOptions := environmentWait.WaitOptions{
+		Interval: defaultWatchInterval,
+	}
+
+	command := &cobra.Command{
+		Use: "wait",
+
+		Short: "Wait until the environment reaches a state",
+		Long: "Wait until the environment reaches a state: " + environmentWait.JoinWaitConditions() + ".\n" +
+			"Deployed means the last pipeline succeeded and the environment is not stopped.\n" +
+			"Exits with 2 when the last operation failed and with 3 when the wait timeout is reached.",
+
+		ValidArgsFunction: cobra.NoFileCompletions,
+
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if err := lib.ValidateInterval(waitOptions.Interval); err != nil {
+				return err
+			}
+
+			waitCondition, err := environmentWait.ParseWaitCondition(condition)
+			if err != nil {
+				return err
+			}
+
+			waitOptions.Condition = waitCondition
+
+			return nil
+		},
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			environmentID := settings.Profile.Context.Environment
+
+			waitOptions.OnChange = func(status *environmentWait.Status) {
+				if !settings.IsStylish() {
+					return
+				}
+
+				if status == nil {
+					cmd.Printf("Environment %s does not exist\n", environmentID)
+
+					return
+				}
+
+				cmd.Printf("Environment %s is %s, %s\n", environmentID, status.ClusterStatus, status.OperationStatus)
+			}
+
+			status, err := environmentWait.Wait(environmentID, waitOptions)
+
+			switch {
+			case errors.Is(err, environmentWait.ErrWaitFailed):
+				return lib.NewExitCodeError(waitFailedExitCode, err)
+			case errors.Is(err, environmentWait.ErrWaitTimeout):
+				return lib.NewExitCodeError(waitTimeoutExitCode, err)
+			case err != nil:
+				return lib.FormatCommandError(cmd, err)
+			}
+
+			if status == nil || settings.IsStylish() {
+				return nil
+			}
+
+			return lib.FormatCommandData(cmd, status)
+		},
+	}
+
+	flags := command.Flags()
+
+	flags.AddFlag(options.Environment.GetRequiredFlag("id"))
+
+	flags.StringVar(&condition, "for", condition, "Condition to wait for: "+environmentWait.JoinWaitConditions())
+	flags.DurationVar(&waitOptions.Interval, "interval", waitOptions.Interval, "Status check interval")
+	flags.DurationVar(&waitOptions.Timeout, "wait-timeout", waitOptions.Timeout, "Stop waiting after the given duration (0 waits indefinitely)")
+
+	_ = command.MarkFlagRequired("for")
+
+	_ = command.RegisterFlagCompletionFunc("for", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		conditions := make([]string, 0, len(environmentWait.WaitConditions))
+		for _, item := range environmentWait.WaitConditions {
+			conditions = append(conditions, string(item))
+		}
+
+		return conditions, cobra.ShellCompDirectiveNoFileComp
+	})
+
+	mainCmd.AddCommand(command)
+}
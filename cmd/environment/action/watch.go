@@ -0,0 +1,93 @@
+package action
+
+import (
+	"errors"
+	"time"
+
+	"bunnyshell.com/cli/pkg/config"
+	environmentStatus "bunnyshell.com/cli/pkg/environment"
+	"bunnyshell.com/cli/pkg/lib"
+	"github.com/spf13/cobra"
+)
+
+const defaultWatchInterval = 5 * time.Second
+
+var errWatchTimeout = errors.New("environment did not settle in time")
+
+func init() {
+	options := config.GetOptions()
+	settings := config.GetSettings()
+
+	interval := defaultWatchInterval
+	timeout := time.Duration(0)
+
+	command := &cobra.Command{
+		Use: "watch",
+
+		Short: "Watch the environment and its components until no operation is in progress",
+		Long: "Watch the environment and its components until no operation is in progress.\n" +
+			"The statuses are printed again every time they change, or only once settled with an output format other than stylish.",
+
+		ValidArgsFunction: cobra.NoFileCompletions,
+
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return lib.ValidateInterval(interval)
+		},
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			environmentID := settings.Profile.Context.Environment
+
+			var (
+				previous *environmentStatus.Status
+				deadline time.Time
+			)
+
+			if timeout > 0 {
+				deadline = time.Now().Add(timeout)
+			}
+
+			for {
+				status, err := environmentStatus.GetStatus(environmentID)
+				if err != nil {
+					return lib.FormatCommandError(cmd, err)
+				}
+
+				if status.IsSettled() && !settings.IsStylish() {
+					return lib.FormatCommandData(cmd, status)
+				}
+
+				// structured output stays a single document, only stylish is rendered on every change
+				if settings.IsStylish() && !status.Equal(previous) {
+					if previous != nil {
+						cmd.Println()
+					}
+
+					if err = lib.FormatCommandData(cmd, status); err != nil {
+						return err
+					}
+				}
+
+				if status.IsSettled() {
+					return nil
+				}
+
+				if !deadline.IsZero() && time.Now().After(deadline) {
+					return errWatchTimeout
+				}
+
+				previous = status
+
+				time.Sleep(interval)
+			}
+		},
+	}
+
+	flags := command.Flags()
+
+	flags.AddFlag(options.Environment.GetRequiredFlag("id"))
+
+	flags.DurationVar(&interval, "interval", interval, "Status check interval")
+	flags.DurationVar(&timeout, "watch-timeout", timeout, "Stop watching after the given duration (0 watches until settled)")
+
+	mainCmd.AddCommand(command)
+}
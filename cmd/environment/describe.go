@@ -0,0 +1,38 @@
+package environment
+
+import (
+	"bunnyshell.com/cli/pkg/config"
+	environmentDescription "bunnyshell.com/cli/pkg/environment"
+	"bunnyshell.com/cli/pkg/lib"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	options := config.GetOptions()
+	settings := config.GetSettings()
+
+	command := &cobra.Command{
+		Use:     "describe",
+		Aliases: []string{"details"},
+		GroupID: mainGroup.ID,
+
+		Short: "Show an environment along with its components and endpoints",
+
+		ValidArgsFunction: cobra.NoFileCompletions,
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			model, err := environmentDescription.Describe(settings.Profile.Context.Environment)
+			if err != nil {
+				return lib.FormatCommandError(cmd, err)
+			}
+
+			return lib.FormatCommandData(cmd, model)
+		},
+	}
+
+	flags := command.Flags()
+
+	flags.AddFlag(options.Environment.GetRequiredFlag("id"))
+
+	mainCmd.AddCommand(command)
+}
@@ -1,18 +1,31 @@
 package environment
 
 import (
+	"errors"
+
 	"bunnyshell.com/cli/pkg/api/environment"
+	"bunnyshell.com/cli/pkg/api/project"
 	"bunnyshell.com/cli/pkg/config"
+	environmentHealth "bunnyshell.com/cli/pkg/environment"
 	"bunnyshell.com/cli/pkg/lib"
 	"bunnyshell.com/cli/pkg/util"
 	"github.com/spf13/cobra"
 )
 
+var errUnhealthyEnvironments = errors.New("unhealthy environments found")
+
 func init() {
 	options := config.GetOptions()
 	settings := config.GetSettings()
 
 	listOptions := environment.NewListOptions()
+	aggregateOptions := environment.NewAggregateOptions()
+
+	allProjects := false
+	health := false
+	failOnUnhealthy := false
+
+	onelineOptions := lib.OnelineOptions{}
 
 	command := &cobra.Command{
 		Use:     "list",
@@ -26,6 +39,23 @@ func init() {
 			listOptions.Organization = settings.Profile.Context.Organization
 			listOptions.Project = settings.Profile.Context.Project
 
+			aggregateOptions.ListOptions = *listOptions
+
+			onelineOptions.Apply()
+
+			if allProjects {
+				// the context project would narrow every project listing
+				aggregateOptions.Project = ""
+			}
+
+			if health {
+				return showHealth(cmd, aggregateOptions, allProjects, failOnUnhealthy)
+			}
+
+			if allProjects {
+				return showAllProjects(cmd, aggregateOptions)
+			}
+
 			return lib.ShowCollection(cmd, listOptions, func() (lib.ModelWithPagination, error) {
 				return environment.List(listOptions)
 			})
@@ -37,7 +67,69 @@ func init() {
 	flags.AddFlag(options.Organization.GetFlag("organization"))
 	flags.AddFlag(options.Project.GetFlag("project"))
 
+	flags.BoolVar(&allProjects, "all-projects", allProjects, "List environments from all projects within the organization, ignoring --project")
+
+	flags.BoolVar(&health, "health", health, "Show the health of each environment: components ready and last deployment status")
+	flags.BoolVar(&failOnUnhealthy, "fail-on-unhealthy", failOnUnhealthy, "Exit with an error if any environment is unhealthy")
+	command.MarkFlagsRequiredTogether("fail-on-unhealthy", "health")
+
 	listOptions.UpdateFlagSet(flags)
+	command.MarkFlagsMutuallyExclusive("status", "clusterStatus")
+	command.MarkFlagsMutuallyExclusive("operation-status", "operationStatus")
+
+	aggregateOptions.UpdateFlagSet(flags)
+	onelineOptions.UpdateFlagSet(flags)
+	command.MarkFlagsMutuallyExclusive("oneline", "health")
 
 	mainCmd.AddCommand(command)
 }
+
+func showAllProjects(cmd *cobra.Command, options *environment.AggregateOptions) error {
+	items, failures, err := environment.AggregateProjects(options)
+	if err != nil {
+		return lib.FormatCommandError(cmd, err)
+	}
+
+	return project.ShowAggregateListing(cmd, &project.AggregateListing{
+		Items:    items,
+		Failures: failures,
+	})
+}
+
+func showHealth(cmd *cobra.Command, options *environment.AggregateOptions, allProjects bool, failOnUnhealthy bool) error {
+	if !allProjects {
+		environments, err := environment.ListAll(options.ListOptions)
+		if err != nil {
+			return lib.FormatCommandError(cmd, err)
+		}
+
+		report := environmentHealth.CheckHealth(environments, options.Concurrency)
+
+		if err = lib.FormatCommandData(cmd, report); err != nil {
+			return err
+		}
+
+		return checkHealthy(report, failOnUnhealthy)
+	}
+
+	environments, failures, err := environment.AggregateProjects(options)
+	if err != nil {
+		return lib.FormatCommandError(cmd, err)
+	}
+
+	report := environmentHealth.CheckHealth(environments, options.Concurrency)
+
+	if err = project.ShowAggregateListing(cmd, &project.AggregateListing{Items: report, Failures: failures}); err != nil {
+		return err
+	}
+
+	return checkHealthy(report, failOnUnhealthy)
+}
+
+func checkHealthy(report environmentHealth.HealthReport, failOnUnhealthy bool) error {
+	if failOnUnhealthy && !report.IsHealthy() {
+		return errUnhealthyEnvironments
+	}
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package environment
+
+import (
+	"errors"
+
+	"bunnyshell.com/cli/pkg/config"
+	environmentHealth "bunnyshell.com/cli/pkg/environment"
+	"bunnyshell.com/cli/pkg/lib"
+	"github.com/spf13/cobra"
+)
+
+const unhealthyExitCode = 2
+
+var errEnvironmentUnhealthy = errors.New("environment is not healthy")
+
+func init() {
+	options := config.GetOptions()
+	settings := config.GetSettings()
+
+	exitCode := true
+
+	command := &cobra.Command{
+		Use:     "status",
+		GroupID: mainGroup.ID,
+
+		Short: "Show the operation status and health of an environment, exiting with 2 when it is not healthy",
+		Long:  "Show the operation status and health of an environment.\nExits with 2 when the environment is not healthy, to gate CI steps, unless --exit-code=false is given.",
+
+		ValidArgsFunction: cobra.NoFileCompletions,
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			item, err := environmentHealth.GetHealth(settings.Profile.Context.Environment)
+			if err != nil {
+				return lib.FormatCommandError(cmd, err)
+			}
+
+			if err = lib.FormatCommandData(cmd, item); err != nil {
+				return err
+			}
+
+			if exitCode && !item.Healthy {
+				return lib.NewExitCodeError(unhealthyExitCode, errEnvironmentUnhealthy)
+			}
+
+			return nil
+		},
+	}
+
+	flags := command.Flags()
+
+	flags.AddFlag(options.Environment.GetRequiredFlag("id"))
+
+	flags.BoolVar(&exitCode, "exit-code", exitCode, "Exit with 2 when the environment is not healthy, use --exit-code=false to only show the status")
+
+	mainCmd.AddCommand(command)
+}
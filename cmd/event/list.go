@@ -12,6 +12,7 @@ func init() {
 	settings := config.GetSettings()
 
 	listOptions := event.NewListOptions()
+	onelineOptions := lib.OnelineOptions{}
 
 	command := &cobra.Command{
 		Use: "list",
@@ -22,6 +23,23 @@ func init() {
 			listOptions.Organization = settings.Profile.Context.Organization
 			listOptions.Environment = settings.Profile.Context.Environment
 
+			onelineOptions.Apply()
+
+			if listOptions.All {
+				model, err := event.ListAll(listOptions)
+				if err != nil {
+					return lib.FormatCommandError(cmd, err)
+				}
+
+				return lib.FormatCommandData(cmd, model)
+			}
+
+			if listOptions.PageSize > 0 {
+				return lib.ShowCollection(cmd, listOptions, func() (lib.ModelWithPagination, error) {
+					return event.ListPage(listOptions)
+				})
+			}
+
 			return lib.ShowCollection(cmd, listOptions, func() (lib.ModelWithPagination, error) {
 				return event.List(listOptions)
 			})
@@ -34,6 +52,9 @@ func init() {
 	flags.AddFlag(options.Environment.GetFlag("environment"))
 
 	listOptions.UpdateFlagSet(flags)
+	onelineOptions.UpdateFlagSet(flags)
+	command.MarkFlagsMutuallyExclusive("all", "page")
+	command.MarkFlagsMutuallyExclusive("all", "page-size")
 
 	mainCmd.AddCommand(command)
 }
@@ -7,7 +7,7 @@ import (
 
 var mainCmd = &cobra.Command{
 	Use:     "k8s-clusters",
-	Aliases: []string{"k8s"},
+	Aliases: []string{"k8s", "k8s-integration", "k8s-integrations"},
 
 	Short: "Kubernetes Cluster Integrations",
 	Long:  "Bunnyshell Kubernetes Cluster Integrations",
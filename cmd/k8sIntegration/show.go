@@ -32,6 +32,7 @@ func init() {
 	flags := command.Flags()
 
 	flags.AddFlag(getIDOption(&itemOptions.ID).GetRequiredFlag("id"))
+	k8s.RegisterFlagCompletion(command, "id")
 
 	mainCmd.AddCommand(command)
 }
@@ -0,0 +1,37 @@
+package organization
+
+import (
+	"bunnyshell.com/cli/pkg/config"
+	"bunnyshell.com/cli/pkg/lib"
+	"bunnyshell.com/cli/pkg/organization"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	options := config.GetOptions()
+	settings := config.GetSettings()
+
+	command := &cobra.Command{
+		Use: "usage",
+
+		Short: "Show the environment counts and the running pipelines of the organization",
+		Long:  "Show the environment counts, by type and status, and the pipelines in progress or pending in the organization.\nPlan limits are not available from the API.",
+
+		ValidArgsFunction: cobra.NoFileCompletions,
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			model, err := organization.GetOrganizationUsage(settings.Profile.Context.Organization)
+			if err != nil {
+				return lib.FormatCommandError(cmd, err)
+			}
+
+			return lib.FormatCommandData(cmd, model)
+		},
+	}
+
+	flags := command.Flags()
+
+	flags.AddFlag(options.Organization.GetRequiredFlag("id"))
+
+	mainCmd.AddCommand(command)
+}
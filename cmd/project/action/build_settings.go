@@ -0,0 +1,63 @@
+package action
+
+import (
+	"bunnyshell.com/cli/pkg/api/project"
+	"bunnyshell.com/cli/pkg/config"
+	"bunnyshell.com/cli/pkg/lib"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	command := &cobra.Command{
+		Use: "build-settings",
+
+		Short: "Show or update the build cluster, registry and builder resources of the project",
+		Long:  "Show or update the build cluster, registry and builder resources of the project.\nEnvironments of the project use them, unless they have their own build settings.",
+
+		ValidArgsFunction: cobra.NoFileCompletions,
+	}
+
+	command.AddCommand(newBuildSettingsShowCommand())
+	command.AddCommand(newUpdateBuildSettingsCommand("update"))
+
+	mainCmd.AddCommand(command)
+}
+
+func newBuildSettingsShowCommand() *cobra.Command {
+	options := config.GetOptions()
+	settings := config.GetSettings()
+
+	itemOptions := project.NewItemOptions("")
+
+	command := &cobra.Command{
+		Use: "show",
+
+		Short: "Show the build cluster, registry and builder resources of the project",
+
+		ValidArgsFunction: cobra.NoFileCompletions,
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			itemOptions.ID = settings.Profile.Context.Project
+
+			model, err := project.Get(itemOptions)
+			if err != nil {
+				return lib.FormatCommandError(cmd, err)
+			}
+
+			buildSettings, ok := model.GetBuildSettingsOk()
+			if (!ok || buildSettings == nil) && settings.IsStylish() {
+				cmd.Printf("Project %s has no build settings\n", itemOptions.ID)
+
+				return nil
+			}
+
+			return lib.FormatCommandData(cmd, buildSettings)
+		},
+	}
+
+	flags := command.Flags()
+
+	flags.AddFlag(options.Project.GetRequiredFlag("id"))
+
+	return command
+}
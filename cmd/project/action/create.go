@@ -4,11 +4,15 @@ import (
 	"errors"
 
 	"bunnyshell.com/cli/pkg/api"
+	"bunnyshell.com/cli/pkg/api/build_settings"
+	"bunnyshell.com/cli/pkg/api/k8s"
 	"bunnyshell.com/cli/pkg/api/project"
 	"bunnyshell.com/cli/pkg/config"
 	"bunnyshell.com/cli/pkg/lib"
 	"bunnyshell.com/cli/pkg/util"
+	"bunnyshell.com/sdk"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 )
 
 func init() {
@@ -16,10 +20,18 @@ func init() {
 	settings := config.GetSettings()
 
 	createOptions := project.NewCreateOptions()
+	editBuildSettingsOptions := project.NewEditBuildSettingsOptions("")
+
+	buildSettingsFlags := pflag.NewFlagSet("build-settings", pflag.ContinueOnError)
 
 	command := &cobra.Command{
 		Use: "create",
 
+		Short: "Create a project",
+		Long:  "Create a project, with its labels and optionally its build settings, the defaults for the environments of the project.",
+
+		Example: "create --organization OrganizationID --name backend --label team=payments --use-managed-registry --use-managed-k8s",
+
 		ValidArgsFunction: cobra.NoFileCompletions,
 
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -36,6 +48,27 @@ func init() {
 				return lib.FormatCommandError(cmd, err)
 			}
 
+			if !hasChangedFlags(buildSettingsFlags) {
+				return lib.FormatCommandData(cmd, model)
+			}
+
+			editBuildSettingsOptions.ID = model.GetId()
+
+			if _, err = project.EditBuildSettings(editBuildSettingsOptions); err != nil {
+				cmd.Printf("Project %s created, the build settings were not applied\n", model.GetId())
+
+				return lib.FormatCommandError(cmd, err)
+			}
+
+			model, err = build_settings.CheckBuildSettingsValidation[sdk.ProjectItem](
+				project.Get,
+				&editBuildSettingsOptions.EditOptions,
+				settings.IsStylish(),
+			)
+			if err != nil {
+				return lib.FormatCommandError(cmd, err)
+			}
+
 			return lib.FormatCommandData(cmd, model)
 		},
 	}
@@ -51,9 +84,24 @@ func init() {
 
 	createOptions.UpdateFlagSet(flags)
 
+	editBuildSettingsOptions.UpdateFlagSet(buildSettingsFlags)
+	flags.AddFlagSet(buildSettingsFlags)
+
+	k8s.RegisterFlagCompletion(command, "k8s")
+
 	mainCmd.AddCommand(command)
 }
 
+func hasChangedFlags(flags *pflag.FlagSet) bool {
+	changed := false
+
+	flags.VisitAll(func(flag *pflag.Flag) {
+		changed = changed || flag.Changed
+	})
+
+	return changed
+}
+
 func handleCreateErrors(cmd *cobra.Command, apiError api.Error, createOptions *project.CreateOptions) error {
 	if len(apiError.Violations) == 0 {
 		return apiError
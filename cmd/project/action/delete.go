@@ -1,27 +1,64 @@
 package action
 
 import (
+	"errors"
+	"fmt"
+
+	"bunnyshell.com/cli/pkg/api/environment"
 	"bunnyshell.com/cli/pkg/api/project"
 	"bunnyshell.com/cli/pkg/config"
+	"bunnyshell.com/cli/pkg/interactive"
 	"bunnyshell.com/cli/pkg/lib"
 	"github.com/spf13/cobra"
 )
 
+var (
+	errProjectHasEnvironments = errors.New("the project still has environments, delete them first or use --force")
+	errConfirmationMismatch   = errors.New("the typed name does not match the project name")
+)
+
 func init() {
 	options := config.GetOptions()
 	settings := config.GetSettings()
 
 	deleteOptions := project.NewDeleteOptions()
+	force := false
+	yes := false
 
 	command := &cobra.Command{
 		Use: "delete",
 
+		Short: "Delete a project",
+		Long: "Delete a project, refusing while it still has environments unless --force is given.\n" +
+			"Asks to type the project name as confirmation, unless --yes is given.",
+
 		ValidArgsFunction: cobra.NoFileCompletions,
 
 		RunE: func(cmd *cobra.Command, args []string) error {
 			deleteOptions.ID = settings.Profile.Context.Project
 
-			err := project.Delete(deleteOptions)
+			model, err := project.Get(project.NewItemOptions(deleteOptions.ID))
+			if err != nil {
+				return lib.FormatCommandError(cmd, err)
+			}
+
+			if !force {
+				if err = ensureNoEnvironments(deleteOptions.ID); err != nil {
+					return lib.FormatCommandError(cmd, err)
+				}
+			}
+
+			if !yes {
+				if settings.NonInteractive {
+					return lib.ErrDeleteConfirmationRequired
+				}
+
+				if err = confirmProjectName(model.GetName()); err != nil {
+					return err
+				}
+			}
+
+			err = project.Delete(deleteOptions)
 			if err != nil {
 				return lib.FormatCommandError(cmd, err)
 			}
@@ -36,5 +73,37 @@ func init() {
 
 	flags.AddFlag(options.Project.GetRequiredFlag("id"))
 
+	flags.BoolVar(&force, "force", force, "Delete the project even if it still has environments")
+	flags.BoolVar(&yes, "yes", yes, "Skip typing the project name as confirmation")
+
 	mainCmd.AddCommand(command)
 }
+
+func ensureNoEnvironments(projectID string) error {
+	listOptions := environment.NewListOptions()
+	listOptions.Project = projectID
+
+	model, err := environment.List(listOptions)
+	if err != nil {
+		return err
+	}
+
+	if count := model.GetTotalItems(); count > 0 {
+		return fmt.Errorf("%w: %d environment(s)", errProjectHasEnvironments, count)
+	}
+
+	return nil
+}
+
+func confirmProjectName(name string) error {
+	answer, err := interactive.Ask(fmt.Sprintf("Type the project name (%s) to confirm the deletion:", name), nil)
+	if err != nil {
+		return err
+	}
+
+	if answer != name {
+		return errConfirmationMismatch
+	}
+
+	return nil
+}
@@ -2,6 +2,7 @@ package action
 
 import (
 	"bunnyshell.com/cli/pkg/api/build_settings"
+	"bunnyshell.com/cli/pkg/api/k8s"
 	"bunnyshell.com/cli/pkg/api/project"
 	"bunnyshell.com/cli/pkg/config"
 	"bunnyshell.com/cli/pkg/lib"
@@ -11,13 +12,19 @@ import (
 )
 
 func init() {
+	mainCmd.AddCommand(newUpdateBuildSettingsCommand("update-build-settings"))
+}
+
+func newUpdateBuildSettingsCommand(use string) *cobra.Command {
 	options := config.GetOptions()
 	settings := config.GetSettings()
 
 	editBuildSettingsOptions := project.NewEditBuildSettingsOptions("")
 
 	command := &cobra.Command{
-		Use: "update-build-settings",
+		Use: use,
+
+		Short: "Update the build cluster, registry and builder resources of the project",
 
 		ValidArgsFunction: cobra.NoFileCompletions,
 
@@ -48,5 +55,7 @@ func init() {
 
 	editBuildSettingsOptions.UpdateFlagSet(flags)
 
-	mainCmd.AddCommand(command)
+	k8s.RegisterFlagCompletion(command, "k8s")
+
+	return command
 }
@@ -1,30 +1,80 @@
 package action
 
 import (
+	"errors"
+
+	"bunnyshell.com/cli/pkg/api/build_settings"
+	"bunnyshell.com/cli/pkg/api/k8s"
 	"bunnyshell.com/cli/pkg/api/project"
 	"bunnyshell.com/cli/pkg/config"
 	"bunnyshell.com/cli/pkg/lib"
 	"bunnyshell.com/cli/pkg/util"
+	"bunnyshell.com/sdk"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 )
 
+var errNothingToEdit = errors.New("nothing to update, give a new name, labels or build settings")
+
 func init() {
 	options := config.GetOptions()
 	settings := config.GetSettings()
 
 	editSettingsOptions := project.NewEditSettingsOptions("")
+	editBuildSettingsOptions := project.NewEditBuildSettingsOptions("")
+
+	settingsFlags := pflag.NewFlagSet("settings", pflag.ContinueOnError)
+	buildSettingsFlags := pflag.NewFlagSet("build-settings", pflag.ContinueOnError)
 
 	command := &cobra.Command{
-		Use: "update-settings",
+		Use:     "update-settings",
+		Aliases: []string{"edit"},
+
+		Short: "Rename a project, update its labels or build settings",
+
+		Example: "edit --id ProjectID --name backend-v2 --label team=payments\nedit --id ProjectID --use-managed-k8s --cpu 0.5",
 
 		ValidArgsFunction: cobra.NoFileCompletions,
 
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if !hasChangedFlags(settingsFlags) && !hasChangedFlags(buildSettingsFlags) {
+				return errNothingToEdit
+			}
+
+			return nil
+		},
+
 		RunE: func(cmd *cobra.Command, args []string) error {
 			editSettingsOptions.ID = settings.Profile.Context.Project
 
-			model, err := project.EditSettings(editSettingsOptions)
-			if err != nil {
-				return lib.FormatCommandError(cmd, err)
+			var model *sdk.ProjectItem
+
+			if hasChangedFlags(settingsFlags) {
+				updated, err := project.EditSettings(editSettingsOptions)
+				if err != nil {
+					return lib.FormatCommandError(cmd, err)
+				}
+
+				model = updated
+			}
+
+			if hasChangedFlags(buildSettingsFlags) {
+				editBuildSettingsOptions.ID = editSettingsOptions.ID
+
+				if _, err := project.EditBuildSettings(editBuildSettingsOptions); err != nil {
+					return lib.FormatCommandError(cmd, err)
+				}
+
+				updated, err := build_settings.CheckBuildSettingsValidation[sdk.ProjectItem](
+					project.Get,
+					&editBuildSettingsOptions.EditOptions,
+					settings.IsStylish(),
+				)
+				if err != nil {
+					return lib.FormatCommandError(cmd, err)
+				}
+
+				model = updated
 			}
 
 			return lib.FormatCommandData(cmd, model)
@@ -35,7 +85,13 @@ func init() {
 
 	flags.AddFlag(options.Project.GetFlag("id", util.FlagRequired))
 
-	editSettingsOptions.UpdateFlagSet(flags)
+	editSettingsOptions.UpdateFlagSet(settingsFlags)
+	flags.AddFlagSet(settingsFlags)
+
+	editBuildSettingsOptions.UpdateFlagSet(buildSettingsFlags)
+	flags.AddFlagSet(buildSettingsFlags)
+
+	k8s.RegisterFlagCompletion(command, "k8s")
 
 	mainCmd.AddCommand(command)
 }
@@ -0,0 +1,37 @@
+package project
+
+import (
+	"bunnyshell.com/cli/pkg/config"
+	"bunnyshell.com/cli/pkg/lib"
+	"bunnyshell.com/cli/pkg/project"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	options := config.GetOptions()
+	settings := config.GetSettings()
+
+	command := &cobra.Command{
+		Use:     "describe",
+		Aliases: []string{"details"},
+
+		Short: "Show a project along with a summary of its environments",
+
+		ValidArgsFunction: cobra.NoFileCompletions,
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			model, err := project.DescribeProject(settings.Profile.Context.Project)
+			if err != nil {
+				return lib.FormatCommandError(cmd, err)
+			}
+
+			return lib.FormatCommandData(cmd, model)
+		},
+	}
+
+	flags := command.Flags()
+
+	flags.AddFlag(options.Project.GetRequiredFlag("id"))
+
+	mainCmd.AddCommand(command)
+}
@@ -2,6 +2,7 @@ package project
 
 import (
 	"bunnyshell.com/cli/cmd/project/action"
+	"bunnyshell.com/cli/cmd/project_variable"
 	"bunnyshell.com/cli/pkg/config"
 	"bunnyshell.com/cli/pkg/util"
 	"github.com/spf13/cobra"
@@ -28,6 +29,15 @@ func init() {
 		},
 		action.GetMainCommand().Commands(),
 	)
+
+	util.AddGroupedCommands(
+		mainCmd,
+		cobra.Group{
+			ID:    "variables",
+			Title: "Commands for Project Variables:",
+		},
+		[]*cobra.Command{project_variable.GetAliasCommand()},
+	)
 }
 
 func GetMainCommand() *cobra.Command {
@@ -1,25 +1,68 @@
 package action
 
 import (
+	"fmt"
+
 	"bunnyshell.com/cli/pkg/api/project_variable"
+	"bunnyshell.com/cli/pkg/config"
+	"bunnyshell.com/cli/pkg/interactive"
 	"bunnyshell.com/cli/pkg/lib"
 	"github.com/spf13/cobra"
 )
 
 func init() {
+	options := config.GetOptions()
+	settings := config.GetSettings()
+
 	deleteOptions := project_variable.NewDeleteOptions()
+	name := ""
+	yes := false
 
 	command := &cobra.Command{
 		Use: "delete",
 
+		Short: "Delete a project variable",
+		Long:  "Delete a project variable, given by --id or by --name within the project, which is then required.\nAsks for confirmation unless --yes is given.",
+
+		Example: "delete --id ProjectVariableID\ndelete --project ProjectID --name API_KEY --yes",
+
 		ValidArgsFunction: cobra.NoFileCompletions,
 
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if deleteOptions.ID == "" && name == "" {
+				return errMissingVariable
+			}
+
+			return nil
+		},
+
 		RunE: func(cmd *cobra.Command, args []string) error {
-			err := project_variable.Delete(deleteOptions)
+			id, err := resolveProjectVariableID(deleteOptions.ID, settings.Profile.Context.Project, name)
 			if err != nil {
 				return lib.FormatCommandError(cmd, err)
 			}
 
+			deleteOptions.ID = id
+
+			if !yes {
+				if settings.NonInteractive {
+					return lib.ErrDeleteConfirmationRequired
+				}
+
+				confirmed, err := interactive.Confirm(fmt.Sprintf("Delete project variable %s?", lib.GetVariableLabel(id, name)))
+				if err != nil {
+					return err
+				}
+
+				if !confirmed {
+					return nil
+				}
+			}
+
+			if err = project_variable.Delete(deleteOptions); err != nil {
+				return lib.FormatCommandError(cmd, err)
+			}
+
 			cmd.Printf("\nProject variable %s successfully deleted\n", deleteOptions.ID)
 
 			return nil
@@ -28,7 +71,13 @@ func init() {
 
 	flags := command.Flags()
 
-	flags.AddFlag(GetIDOption(&deleteOptions.ID).GetRequiredFlag("id"))
+	flags.AddFlag(GetIDOption(&deleteOptions.ID).GetFlag("id"))
+
+	flags.AddFlag(options.Project.GetFlag("project"))
+	flags.StringVar(&name, "name", name, "Name of the variable within the project, instead of the id, requires a project")
+	command.MarkFlagsMutuallyExclusive("id", "name")
+
+	flags.BoolVar(&yes, "yes", yes, "Skip the deletion confirmation")
 
 	mainCmd.AddCommand(command)
 }
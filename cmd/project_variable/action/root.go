@@ -4,14 +4,19 @@ import (
 	"errors"
 	"fmt"
 
+	"bunnyshell.com/cli/pkg/api/project_variable"
 	"bunnyshell.com/cli/pkg/build"
 	"bunnyshell.com/cli/pkg/config/option"
+	"bunnyshell.com/sdk"
 	"github.com/spf13/cobra"
 )
 
 var (
 	errMissingValue        = errors.New("the plain value must be provided")
 	errMultipleValueInputs = errors.New("the value must be provided either by argument or by stdin, not both")
+	errMissingVariable     = errors.New("the variable must be given either by --id or by --name within a project")
+	errMissingProject      = errors.New("the project is required when using --name, given by --project or the context")
+	errVariableNotFound    = errors.New("project variable not found")
 )
 
 var mainCmd = &cobra.Command{}
@@ -32,3 +37,48 @@ func GetIDOption(value *string) *option.String {
 
 	return idOption
 }
+
+// resolveProjectVariableID returns the given id, or the id of the variable with the name within the project.
+func resolveProjectVariableID(id string, projectID string, name string) (string, error) {
+	if id != "" {
+		return id, nil
+	}
+
+	if projectID == "" {
+		return "", errMissingProject
+	}
+
+	item, err := findProjectVariable(projectID, name)
+	if err != nil {
+		return "", err
+	}
+
+	if item == nil {
+		return "", fmt.Errorf("%w: %s", errVariableNotFound, name)
+	}
+
+	return item.GetId(), nil
+}
+
+func findProjectVariable(projectID string, name string) (*sdk.ProjectVariableCollection, error) {
+	listOptions := project_variable.NewListOptions()
+	listOptions.Project = projectID
+	listOptions.Name = name
+
+	model, err := project_variable.List(listOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	if !model.HasEmbedded() {
+		return nil, nil
+	}
+
+	for _, item := range model.Embedded.Item {
+		if item.GetName() == name {
+			return &item, nil
+		}
+	}
+
+	return nil, nil
+}
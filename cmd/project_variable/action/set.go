@@ -0,0 +1,85 @@
+package action
+
+import (
+	"strings"
+
+	"bunnyshell.com/cli/pkg/api/project_variable"
+	"bunnyshell.com/cli/pkg/config"
+	"bunnyshell.com/cli/pkg/config/enum"
+	"bunnyshell.com/cli/pkg/lib"
+	"bunnyshell.com/sdk"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	options := config.GetOptions()
+	settings := config.GetSettings()
+
+	isSecret := false
+
+	command := &cobra.Command{
+		Use: "set NAME=value...",
+
+		Short: "Create or update project variables",
+		Long:  "Create or update project variables, the ones already defined on the project get the new value.",
+
+		Example: "set --project ProjectID DEBUG=1 LOG_LEVEL=info",
+
+		Args: cobra.MatchAll(cobra.MinimumNArgs(1), lib.VariablePairs),
+
+		ValidArgsFunction: cobra.NoFileCompletions,
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			projectID := settings.Profile.Context.Project
+
+			for _, pair := range args {
+				name, value, _ := strings.Cut(pair, "=")
+
+				if _, err := setProjectVariable(projectID, name, value, isSecret); err != nil {
+					return lib.FormatCommandError(cmd, err)
+				}
+
+				cmd.Printf("Project variable %s successfully set\n", name)
+			}
+
+			return nil
+		},
+	}
+
+	flags := command.Flags()
+
+	flags.AddFlag(options.Project.GetRequiredFlag("project"))
+
+	flags.BoolVar(&isSecret, "secret", isSecret, "Set the variables as secrets")
+
+	mainCmd.AddCommand(command)
+}
+
+func setProjectVariable(projectID string, name string, value string, isSecret bool) (*sdk.ProjectVariableItem, error) {
+	existing, err := findProjectVariable(projectID, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if existing == nil {
+		createOptions := project_variable.NewCreateOptions()
+		createOptions.Project = projectID
+		createOptions.Name = name
+		createOptions.Value = value
+
+		if isSecret {
+			createOptions.IsSecret = enum.BoolTrue
+		}
+
+		return project_variable.Create(createOptions)
+	}
+
+	editOptions := project_variable.NewEditOptions(existing.GetId())
+	editOptions.ProjectVariableEditAction.SetValue(value)
+
+	if isSecret {
+		editOptions.IsSecret = enum.BoolTrue
+	}
+
+	return project_variable.Edit(editOptions)
+}
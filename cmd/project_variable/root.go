@@ -1,7 +1,10 @@
 package project_variable
 
 import (
+	"fmt"
+
 	"bunnyshell.com/cli/cmd/project_variable/action"
+	"bunnyshell.com/cli/pkg/build"
 	"bunnyshell.com/cli/pkg/config"
 	"bunnyshell.com/cli/pkg/util"
 	"github.com/spf13/cobra"
@@ -31,3 +34,24 @@ func init() {
 func GetMainCommand() *cobra.Command {
 	return mainCmd
 }
+
+// GetAliasCommand returns the "variables" command for within "projects", which runs the project variables commands.
+func GetAliasCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:     "variables",
+		Aliases: []string{"vars"},
+
+		Short: fmt.Sprintf("Project Variables, same as \"%s %s\"", build.Name, mainCmd.Name()),
+
+		// flags and errors are handled by the project variables commands
+		DisableFlagParsing: true,
+		SilenceErrors:      true,
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			root := cmd.Root()
+			root.SetArgs(append([]string{mainCmd.Name()}, args...))
+
+			return root.Execute()
+		},
+	}
+}
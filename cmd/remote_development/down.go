@@ -1,6 +1,8 @@
 package remote_development
 
 import (
+	"errors"
+
 	"bunnyshell.com/cli/pkg/config"
 	"bunnyshell.com/cli/pkg/k8s/bridge"
 	"bunnyshell.com/cli/pkg/lib"
@@ -10,6 +12,8 @@ import (
 	"github.com/spf13/cobra"
 )
 
+const noActiveSessionExitCode = 3
+
 func init() {
 	options := config.GetOptions()
 	settings := config.GetSettings()
@@ -36,7 +40,18 @@ func init() {
 
 			downAction := action.NewDown(*resourceLoader.Environment)
 
-			return downAction.Run(downParameters)
+			err = downAction.Run(downParameters)
+			if !errors.Is(err, action.ErrNoActiveSession) {
+				return err
+			}
+
+			if downOptions.Strict {
+				return lib.NewExitCodeError(noActiveSessionExitCode, err)
+			}
+
+			cmd.Println("No active remote development session")
+
+			return nil
 		},
 	}
 
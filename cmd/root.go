@@ -23,6 +23,7 @@ import (
 	"bunnyshell.com/cli/pkg/build"
 	"bunnyshell.com/cli/pkg/config"
 	"bunnyshell.com/cli/pkg/interactive"
+	"bunnyshell.com/cli/pkg/lib"
 	"bunnyshell.com/cli/pkg/net"
 	"bunnyshell.com/cli/pkg/util"
 	"github.com/spf13/cobra"
@@ -51,6 +52,11 @@ var rootCmd = &cobra.Command{
 
 		manager.Load()
 
+		// names provide ids, which must not be asked for
+		if err := resolveContextNames(); err != nil {
+			return err
+		}
+
 		// try and ask for flags
 		interactive.AskMissingRequiredFlags(cmd)
 
@@ -67,16 +73,22 @@ var rootCmd = &cobra.Command{
 			fmt.Fprintf(os.Stdout, "Using config file: %s\n", config.GetSettings().ConfigFile)
 		}
 
-		cmd.SetOut(os.Stdout)
-		cmd.SetErr(os.Stdout)
+		// a single writer keeps the lines of both in order
+		masked := lib.MaskWriter(os.Stdout)
+		cmd.SetOut(masked)
+		cmd.SetErr(masked)
 
 		return nil
 	},
 }
 
 func Execute() {
-	if err := rootCmd.Execute(); err != nil {
-		os.Exit(1)
+	err := rootCmd.Execute()
+
+	lib.FlushMaskWriters()
+
+	if err != nil {
+		os.Exit(lib.GetExitCode(err))
 	}
 }
 
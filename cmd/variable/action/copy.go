@@ -0,0 +1,112 @@
+package action
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"bunnyshell.com/cli/pkg/api/variable"
+	"bunnyshell.com/cli/pkg/lib"
+	"bunnyshell.com/cli/pkg/util"
+	"bunnyshell.com/sdk"
+	"github.com/spf13/cobra"
+)
+
+var errSecretWithheld = errors.New("the API withheld the secret value")
+
+func init() {
+	fromEnvironment := ""
+	toEnvironment := ""
+	overwrite := false
+
+	command := &cobra.Command{
+		Use: "copy",
+
+		Short: "Copy the variables of an environment into another one",
+		Long: "Copy the variables of an environment into another one, secrets included.\n" +
+			"Variables already in the target environment are skipped unless --overwrite is given.\n" +
+			"Secrets whose value the API withholds cannot be copied, they are reported as failed and left untouched in the target.",
+
+		Example: "copy --from-environment StagingID --to-environment EphemeralID --overwrite",
+
+		ValidArgsFunction: cobra.NoFileCompletions,
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			source, err := getVariableItems(fromEnvironment)
+			if err != nil {
+				return lib.FormatCommandError(cmd, err)
+			}
+
+			target, err := getVariableItems(toEnvironment)
+			if err != nil {
+				return lib.FormatCommandError(cmd, err)
+			}
+
+			existing := map[string]*sdk.EnvironmentVariableItem{}
+			for _, item := range target {
+				existing[item.GetName()] = item
+			}
+
+			sort.Slice(source, func(i, j int) bool {
+				return source[i].GetName() < source[j].GetName()
+			})
+
+			report := ImportReport{}
+
+			for _, item := range source {
+				report = append(report, copyVariable(toEnvironment, item, existing[item.GetName()], overwrite))
+			}
+
+			if err = lib.FormatCommandData(cmd, report); err != nil {
+				return err
+			}
+
+			if failed := report.Count(importFailed); failed != 0 {
+				return fmt.Errorf("%w: %d variable(s)", errImportFailed, failed)
+			}
+
+			return nil
+		},
+	}
+
+	flags := command.Flags()
+
+	flags.StringVar(&fromEnvironment, "from-environment", fromEnvironment, "Environment to copy the variables from")
+	util.MarkFlagRequiredWithHelp(flags.Lookup("from-environment"), "The environment holding the variables to copy")
+
+	flags.StringVar(&toEnvironment, "to-environment", toEnvironment, "Environment to copy the variables into")
+	util.MarkFlagRequiredWithHelp(flags.Lookup("to-environment"), "The environment receiving the variables")
+
+	flags.BoolVar(&overwrite, "overwrite", overwrite, "Update the variables already in the target environment")
+
+	mainCmd.AddCommand(command)
+}
+
+func copyVariable(environmentID string, item *sdk.EnvironmentVariableItem, existing *sdk.EnvironmentVariableItem, overwrite bool) ImportResult {
+	result := ImportResult{Name: item.GetName()}
+
+	if variable.IsValueWithheld(item) {
+		result.Status, result.Error = importFailed, errSecretWithheld.Error()
+
+		return result
+	}
+
+	if existing != nil && (!overwrite || (existing.GetValue() == item.GetValue() && (!item.GetSecret() || existing.GetSecret()))) {
+		result.Status = importSkipped
+
+		return result
+	}
+
+	if _, err := setVariable(environmentID, item.GetName(), item.GetValue(), item.GetSecret(), existing); err != nil {
+		result.Status, result.Error = importFailed, err.Error()
+
+		return result
+	}
+
+	result.Status = importCreated
+	if existing != nil {
+		result.Status = importUpdated
+	}
+
+	return result
+}
@@ -4,6 +4,7 @@ import (
 	"io"
 	"os"
 
+	"bunnyshell.com/cli/pkg/api/component_variable"
 	"bunnyshell.com/cli/pkg/api/variable"
 	"bunnyshell.com/cli/pkg/config"
 	"bunnyshell.com/cli/pkg/lib"
@@ -16,6 +17,7 @@ func init() {
 	settings := config.GetSettings()
 
 	createOptions := variable.NewCreateOptions()
+	componentID := ""
 
 	command := &cobra.Command{
 		Use: "create",
@@ -37,6 +39,10 @@ func init() {
 				return errMultipleValueInputs
 			}
 
+			if componentID == "" {
+				_ = cmd.MarkFlagRequired("environment")
+			}
+
 			return nil
 		},
 
@@ -57,6 +63,10 @@ func init() {
 				createOptions.Value = string(buf)
 			}
 
+			if componentID != "" {
+				return createComponentVariable(cmd, componentID, createOptions)
+			}
+
 			model, err := variable.Create(createOptions)
 			if err != nil {
 				return lib.FormatCommandError(cmd, err)
@@ -72,10 +82,25 @@ func init() {
 		"environment",
 		"Environment for the variable",
 		"Environments contain multiple variables",
-		util.FlagRequired,
 	))
+	flags.StringVar(&componentID, "component", componentID, "Component for the variable, instead of the whole environment")
 
 	createOptions.UpdateFlagSet(flags)
 
 	mainCmd.AddCommand(command)
 }
+
+func createComponentVariable(cmd *cobra.Command, componentID string, createOptions *variable.CreateOptions) error {
+	componentCreateOptions := component_variable.NewCreateOptions()
+	componentCreateOptions.ServiceComponent = componentID
+	componentCreateOptions.Name = createOptions.Name
+	componentCreateOptions.Value = createOptions.Value
+	componentCreateOptions.IsSecret = createOptions.IsSecret
+
+	model, err := component_variable.Create(componentCreateOptions)
+	if err != nil {
+		return lib.FormatCommandError(cmd, err)
+	}
+
+	return lib.FormatCommandData(cmd, model)
+}
@@ -1,25 +1,68 @@
 package action
 
 import (
+	"fmt"
+
 	"bunnyshell.com/cli/pkg/api/variable"
+	"bunnyshell.com/cli/pkg/config"
+	"bunnyshell.com/cli/pkg/interactive"
 	"bunnyshell.com/cli/pkg/lib"
 	"github.com/spf13/cobra"
 )
 
 func init() {
+	options := config.GetOptions()
+	settings := config.GetSettings()
+
 	deleteOptions := variable.NewDeleteOptions()
+	name := ""
+	yes := false
 
 	command := &cobra.Command{
 		Use: "delete",
 
+		Short: "Delete an environment variable",
+		Long:  "Delete an environment variable, given by --id or by --name within the environment, which is then required.\nAsks for confirmation unless --yes is given.",
+
+		Example: "delete --id VariableID\ndelete --environment EnvironmentID --name API_KEY --yes",
+
 		ValidArgsFunction: cobra.NoFileCompletions,
 
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if deleteOptions.ID == "" && name == "" {
+				return errMissingVariable
+			}
+
+			return nil
+		},
+
 		RunE: func(cmd *cobra.Command, args []string) error {
-			err := variable.Delete(deleteOptions)
+			id, err := resolveVariableID(deleteOptions.ID, settings.Profile.Context.Environment, name)
 			if err != nil {
 				return lib.FormatCommandError(cmd, err)
 			}
 
+			deleteOptions.ID = id
+
+			if !yes {
+				if settings.NonInteractive {
+					return lib.ErrDeleteConfirmationRequired
+				}
+
+				confirmed, err := interactive.Confirm(fmt.Sprintf("Delete environment variable %s?", lib.GetVariableLabel(id, name)))
+				if err != nil {
+					return err
+				}
+
+				if !confirmed {
+					return nil
+				}
+			}
+
+			if err = variable.Delete(deleteOptions); err != nil {
+				return lib.FormatCommandError(cmd, err)
+			}
+
 			cmd.Printf("\nEnvironment variable %s successfully deleted\n", deleteOptions.ID)
 
 			return nil
@@ -28,7 +71,13 @@ func init() {
 
 	flags := command.Flags()
 
-	flags.AddFlag(GetIDOption(&deleteOptions.ID).GetRequiredFlag("id"))
+	flags.AddFlag(GetIDOption(&deleteOptions.ID).GetFlag("id"))
+
+	flags.AddFlag(options.Environment.GetFlag("environment"))
+	flags.StringVar(&name, "name", name, "Name of the variable within the environment, instead of the id, requires an environment")
+	command.MarkFlagsMutuallyExclusive("id", "name")
+
+	flags.BoolVar(&yes, "yes", yes, "Skip the deletion confirmation")
 
 	mainCmd.AddCommand(command)
 }
@@ -4,28 +4,45 @@ import (
 	"io"
 	"os"
 
+	"bunnyshell.com/cli/pkg/api/component_variable"
 	"bunnyshell.com/cli/pkg/api/variable"
+	"bunnyshell.com/cli/pkg/config"
 	"bunnyshell.com/cli/pkg/lib"
 	"bunnyshell.com/cli/pkg/util"
 	"github.com/spf13/cobra"
 )
 
 func init() {
+	options := config.GetOptions()
+	settings := config.GetSettings()
+
 	editOptions := variable.NewEditOptions("")
+	name := ""
+	valueFromFile := ""
+	componentID := ""
 
 	command := &cobra.Command{
 		Use: "edit",
 
+		Short: "Change the value or the secret flag of an environment variable",
+		Long:  "Change the value or the secret flag of an environment variable.\nThe variable is given by --id, or by --name within the environment, which is then required.",
+
+		Example: "edit --id VariableID --value new-value\nedit --environment EnvironmentID --name TLS_CERT --value-from-file ./cert.pem",
+
 		ValidArgsFunction: cobra.NoFileCompletions,
 
 		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if editOptions.ID == "" && name == "" {
+				return errMissingVariable
+			}
+
 			hasStdin, err := util.IsStdinPresent()
 			if err != nil {
 				return err
 			}
 
 			flags := cmd.Flags()
-			if flags.Changed("value") && hasStdin {
+			if (flags.Changed("value") || flags.Changed("value-from-file")) && hasStdin {
 				return errMultipleValueInputs
 			}
 
@@ -33,23 +50,24 @@ func init() {
 		},
 
 		RunE: func(cmd *cobra.Command, args []string) error {
-			flags := cmd.Flags()
-			if flags.Changed("value") {
-				editOptions.EnvironmentVariableEditAction.SetValue(flags.Lookup("value").Value.String())
+			if componentID != "" {
+				return editComponentVariable(cmd, componentID, name, editOptions, valueFromFile)
 			}
 
-			hasStdin, err := util.IsStdinPresent()
+			id, err := resolveVariableID(editOptions.ID, settings.Profile.Context.Environment, name)
 			if err != nil {
-				return err
+				return lib.FormatCommandError(cmd, err)
 			}
 
-			if hasStdin {
-				buf, err := io.ReadAll(os.Stdin)
-				if err != nil {
-					return err
-				}
+			editOptions.ID = id
 
-				editOptions.EnvironmentVariableEditAction.SetValue(string(buf))
+			value, hasValue, err := getEditValue(cmd, valueFromFile)
+			if err != nil {
+				return err
+			}
+
+			if hasValue {
+				editOptions.EnvironmentVariableEditAction.SetValue(value)
 			}
 
 			model, err := variable.Edit(editOptions)
@@ -63,8 +81,77 @@ func init() {
 
 	flags := command.Flags()
 
-	flags.AddFlag(GetIDOption(&editOptions.ID).GetRequiredFlag("id"))
+	flags.AddFlag(GetIDOption(&editOptions.ID).GetFlag("id"))
+
+	flags.AddFlag(options.Environment.GetFlag("environment"))
+	flags.StringVar(&componentID, "component", componentID, "Component of the variable, instead of the whole environment, --id and --name then refer to a component variable")
+	flags.StringVar(&name, "name", name, "Name of the variable within the environment or component, instead of the id")
+	command.MarkFlagsMutuallyExclusive("id", "name")
+
 	editOptions.UpdateFlagSet(flags)
 
+	flags.StringVar(&valueFromFile, "value-from-file", valueFromFile, "Read the value from a file, for multi-line content")
+	command.MarkFlagsMutuallyExclusive("value", "value-from-file")
+
 	mainCmd.AddCommand(command)
 }
+
+func editComponentVariable(cmd *cobra.Command, componentID string, name string, editOptions *variable.EditOptions, valueFromFile string) error {
+	id, err := resolveComponentVariableID(editOptions.ID, componentID, name)
+	if err != nil {
+		return lib.FormatCommandError(cmd, err)
+	}
+
+	componentEditOptions := component_variable.NewEditOptions(id)
+	componentEditOptions.IsSecret = editOptions.IsSecret
+
+	value, hasValue, err := getEditValue(cmd, valueFromFile)
+	if err != nil {
+		return err
+	}
+
+	if hasValue {
+		componentEditOptions.ServiceComponentVariableEditAction.SetValue(value)
+	}
+
+	model, err := component_variable.Edit(componentEditOptions)
+	if err != nil {
+		return lib.FormatCommandError(cmd, err)
+	}
+
+	return lib.FormatCommandData(cmd, model)
+}
+
+// getEditValue reads the new value from --value, --value-from-file or stdin, reporting whether one was given.
+func getEditValue(cmd *cobra.Command, valueFromFile string) (string, bool, error) {
+	flags := cmd.Flags()
+
+	if flags.Changed("value") {
+		return flags.Lookup("value").Value.String(), true, nil
+	}
+
+	if valueFromFile != "" {
+		content, err := os.ReadFile(valueFromFile)
+		if err != nil {
+			return "", false, err
+		}
+
+		return string(content), true, nil
+	}
+
+	hasStdin, err := util.IsStdinPresent()
+	if err != nil {
+		return "", false, err
+	}
+
+	if !hasStdin {
+		return "", false, nil
+	}
+
+	buf, err := io.ReadAll(os.Stdin)
+	if err != nil {
+		return "", false, err
+	}
+
+	return string(buf), true, nil
+}
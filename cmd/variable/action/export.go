@@ -0,0 +1,205 @@
+package action
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"bunnyshell.com/cli/pkg/api/variable"
+	"bunnyshell.com/cli/pkg/config"
+	"bunnyshell.com/cli/pkg/lib"
+	"bunnyshell.com/cli/pkg/util"
+	"bunnyshell.com/sdk"
+	"github.com/spf13/cobra"
+)
+
+const (
+	exportFormatDotenv = "dotenv"
+	exportFormatJSON   = "json"
+)
+
+var (
+	errUnknownExportFormat = errors.New("unknown export format, expected dotenv or json")
+	errNotDotenvValue      = errors.New("the value cannot be written in dotenv format, use --format json")
+)
+
+func init() {
+	options := config.GetOptions()
+	settings := config.GetSettings()
+
+	format := exportFormatDotenv
+	revealSecrets := false
+
+	command := &cobra.Command{
+		Use: "export",
+
+		Short: "Export the environment variables as a .env file or JSON",
+		Long: "Export the environment variables as a .env file or JSON, to reuse the configuration of an environment locally.\n" +
+			"Secret variables are left out unless --reveal-secrets is given, or when the API withholds their value.",
+
+		Example: "export --environment EnvironmentID > .env\nexport --environment EnvironmentID --format json --reveal-secrets",
+
+		ValidArgsFunction: cobra.NoFileCompletions,
+
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if format != exportFormatDotenv && format != exportFormatJSON {
+				return errUnknownExportFormat
+			}
+
+			return nil
+		},
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			values, secrets, err := getExportValues(settings.Profile.Context.Environment, revealSecrets)
+			if err != nil {
+				return lib.FormatCommandError(cmd, err)
+			}
+
+			// the command errors go to stdout, which is usually redirected to the exported file
+			for _, name := range secrets {
+				if revealSecrets {
+					fmt.Fprintf(os.Stderr, "Secret variable %s left out, the API withheld its value\n", name)
+
+					continue
+				}
+
+				fmt.Fprintf(os.Stderr, "Secret variable %s left out, use --reveal-secrets to export it\n", name)
+			}
+
+			if format == exportFormatJSON {
+				content, err := json.MarshalIndent(values, "", "    ")
+				if err != nil {
+					return err
+				}
+
+				cmd.Println(string(content))
+
+				return nil
+			}
+
+			content, err := formatDotenv(values)
+			if err != nil {
+				return err
+			}
+
+			cmd.Print(content)
+
+			return nil
+		},
+	}
+
+	flags := command.Flags()
+
+	flags.AddFlag(options.Environment.AddFlagWithExtraHelp(
+		"environment",
+		"Environment to export the variables from",
+		"Environments contain multiple variables",
+		util.FlagRequired,
+	))
+
+	flags.StringVar(&format, "format", format, "Export format: dotenv or json")
+	flags.BoolVar(&revealSecrets, "reveal-secrets", revealSecrets, "Include the values of secret variables")
+
+	_ = command.RegisterFlagCompletionFunc("format", cobra.FixedCompletions(
+		[]string{exportFormatDotenv, exportFormatJSON},
+		cobra.ShellCompDirectiveNoFileComp,
+	))
+
+	mainCmd.AddCommand(command)
+}
+
+// getExportValues returns the variable values along with the names of the secrets left out.
+func getExportValues(environmentID string, revealSecrets bool) (map[string]string, []string, error) {
+	items, err := getVariableItems(environmentID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	values := map[string]string{}
+	secrets := []string{}
+
+	for _, item := range items {
+		if item.GetSecret() && (!revealSecrets || variable.IsValueWithheld(item)) {
+			secrets = append(secrets, item.GetName())
+
+			continue
+		}
+
+		values[item.GetName()] = item.GetValue()
+	}
+
+	sort.Strings(secrets)
+
+	return values, secrets, nil
+}
+
+// getVariableItems fetches each variable of the environment, the listing not including the values.
+func getVariableItems(environmentID string) ([]*sdk.EnvironmentVariableItem, error) {
+	listOptions := variable.NewListOptions()
+	listOptions.Environment = environmentID
+
+	collection, err := variable.ListAll(*listOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	items := []*sdk.EnvironmentVariableItem{}
+
+	for _, item := range collection {
+		model, err := variable.Get(variable.NewItemOptions(item.GetId()))
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, model)
+	}
+
+	return items, nil
+}
+
+// formatDotenv quotes the values to keep them literal, as read back by "variables import --file".
+func formatDotenv(values map[string]string) (string, error) {
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	var builder strings.Builder
+
+	for _, name := range names {
+		value, err := quoteDotenvValue(values[name])
+		if err != nil {
+			return "", fmt.Errorf("%w: %s", err, name)
+		}
+
+		fmt.Fprintf(&builder, "%s=%s\n", name, value)
+	}
+
+	return builder.String(), nil
+}
+
+// quoteDotenvValue prefers single quotes, which keep the value literal, and checks the value reads back the same.
+// Some values have no form the dotenv parser reads back, like a backslash right before the closing quote.
+func quoteDotenvValue(value string) (string, error) {
+	replacer := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "$", `\$`, "\n", `\n`, "\r", `\r`)
+
+	candidates := []string{`"` + replacer.Replace(value) + `"`}
+	if !strings.Contains(value, "'") {
+		candidates = append([]string{"'" + value + "'"}, candidates...)
+	}
+
+	for _, quoted := range candidates {
+		parsed := map[string]string{}
+
+		if err := parseDotenv(strings.NewReader("NAME="+quoted), parsed); err == nil && parsed["NAME"] == value {
+			return quoted, nil
+		}
+	}
+
+	return "", errNotDotenvValue
+}
@@ -0,0 +1,64 @@
+package action
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestFormatDotenvRoundTrip(t *testing.T) {
+	values := map[string]string{
+		"PLAIN":            "value",
+		"EMPTY":            "",
+		"SPACES":           "  padded value  ",
+		"APOSTROPHE":       "it's",
+		"DOUBLE_QUOTES":    `say "hi"`,
+		"BOTH_QUOTES":      `it's "quoted"`,
+		"DOLLAR":           "$HOME and ${PATH}",
+		"DOLLAR_QUOTED":    "it's $HOME",
+		"BACKSLASH":        `C:\path\to`,
+		"BACKSLASH_QUOTED": `it's C:\path \$HOME`,
+		"ESCAPES_QUOTED":   `it's \\ "a\"b"`,
+		"HASH":             "value # not a comment",
+		"MULTILINE":        "-----BEGIN KEY-----\nABC\nDEF\n-----END KEY-----\n",
+		"MULTILINE_QUOTED": "it's\nmulti\r\nline",
+		"LITERAL_ESCAPES":  `\n and \t`,
+		"JSON":             `{"name": "it's", "list": [1, 2]}`,
+		"UNICODE":          "ünïcödé ✓",
+	}
+
+	content, err := formatDotenv(values)
+	if err != nil {
+		t.Fatalf("formatDotenv: %v", err)
+	}
+
+	fileName := filepath.Join(t.TempDir(), ".env")
+	if err = os.WriteFile(fileName, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	parsed := map[string]string{}
+	if err = readDotenvFile(fileName, parsed); err != nil {
+		t.Fatalf("readDotenvFile: %v\n%s", err, content)
+	}
+
+	if len(parsed) != len(values) {
+		t.Errorf("read %d variables, expected %d\n%s", len(parsed), len(values), content)
+	}
+
+	for name, value := range values {
+		if parsed[name] != value {
+			t.Errorf("%s: read %q, expected %q", name, parsed[name], value)
+		}
+	}
+}
+
+func TestFormatDotenvRejectsUnreadableValues(t *testing.T) {
+	// the closing quote reads as escaped, and single quotes cannot hold the apostrophe
+	_, err := formatDotenv(map[string]string{"TRAILING_BACKSLASH": `it's \`})
+
+	if !errors.Is(err, errNotDotenvValue) {
+		t.Errorf("expected errNotDotenvValue, got %v", err)
+	}
+}
@@ -0,0 +1,144 @@
+package action
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"text/tabwriter"
+
+	"bunnyshell.com/cli/pkg/lib"
+	"github.com/spf13/cobra"
+	"github.com/subosito/gotenv"
+)
+
+const (
+	importCreated = "created"
+	importUpdated = "updated"
+	importSkipped = "skipped"
+	importFailed  = "failed"
+)
+
+var errImportFailed = errors.New("some variables could not be imported")
+
+type ImportResult struct {
+	Name   string `json:"name" yaml:"name"`
+	Status string `json:"status" yaml:"status"`
+	Error  string `json:"error,omitempty" yaml:"error,omitempty"`
+}
+
+type ImportReport []ImportResult
+
+func (report ImportReport) Count(status string) int {
+	count := 0
+
+	for _, result := range report {
+		if result.Status == status {
+			count++
+		}
+	}
+
+	return count
+}
+
+func (report ImportReport) Tabulate(w *tabwriter.Writer) {
+	fmt.Fprintf(w, "%v\t %v\t %v\n", "Name", "Status", "Error")
+
+	for _, result := range report {
+		fmt.Fprintf(w, "%v\t %v\t %v\n", result.Name, result.Status, result.Error)
+	}
+
+	fmt.Fprintf(
+		w,
+		"\n%d created, %d updated, %d skipped, %d failed\n",
+		report.Count(importCreated),
+		report.Count(importUpdated),
+		report.Count(importSkipped),
+		report.Count(importFailed),
+	)
+}
+
+// readDotenvFile keeps the names as they are, unlike the viper based readFile which lowercases them.
+func readDotenvFile(fileName string, values map[string]string) error {
+	file, err := os.Open(fileName)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	if err = parseDotenv(file, values); err != nil {
+		return fmt.Errorf("%s: %w", fileName, err)
+	}
+
+	return nil
+}
+
+func parseDotenv(reader io.Reader, values map[string]string) error {
+	env, err := gotenv.StrictParse(reader)
+	if err != nil {
+		return err
+	}
+
+	for name, value := range env {
+		values[name] = value
+	}
+
+	return nil
+}
+
+// importDotenv creates the missing variables and updates the changed ones, going on when one of them fails.
+func importDotenv(cmd *cobra.Command, environmentID string, values map[string]string, isSecret bool, ignoreDuplicates bool) error {
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	report := ImportReport{}
+
+	for _, name := range names {
+		report = append(report, importVariable(environmentID, name, values[name], isSecret, ignoreDuplicates))
+	}
+
+	if err := lib.FormatCommandData(cmd, report); err != nil {
+		return err
+	}
+
+	if failed := report.Count(importFailed); failed != 0 {
+		return fmt.Errorf("%w: %d variable(s)", errImportFailed, failed)
+	}
+
+	return nil
+}
+
+func importVariable(environmentID string, name string, value string, isSecret bool, ignoreDuplicates bool) ImportResult {
+	result := ImportResult{Name: name}
+
+	existing, err := findVariable(environmentID, name)
+	if err != nil {
+		result.Status, result.Error = importFailed, err.Error()
+
+		return result
+	}
+
+	if existing != nil && (ignoreDuplicates || (existing.GetValue() == value && (!isSecret || existing.GetSecret()))) {
+		result.Status = importSkipped
+
+		return result
+	}
+
+	if _, err = setVariable(environmentID, name, value, isSecret, existing); err != nil {
+		result.Status, result.Error = importFailed, err.Error()
+
+		return result
+	}
+
+	result.Status = importCreated
+	if existing != nil {
+		result.Status = importUpdated
+	}
+
+	return result
+}
@@ -20,7 +20,10 @@ type BulkImport struct {
 func init() {
 	varFile := ""
 	secretFile := ""
+	envFile := ""
+	envFileSecret := false
 	ignoreDuplicates := false
+	envFileValues := map[string]string{}
 	options := config.GetOptions()
 	data := BulkImport{
 		Vars:    make(map[string]string),
@@ -34,10 +37,14 @@ func init() {
 
 		PreRunE: func(cmd *cobra.Command, args []string) error {
 			//todo add this to preRunE
-			if varFile == "" && secretFile == "" {
+			if varFile == "" && secretFile == "" && envFile == "" {
 				return errors.New("must provide a either a var or secret file")
 			}
 
+			if envFile != "" {
+				return readDotenvFile(envFile, envFileValues)
+			}
+
 			if varFile != "" {
 				if err := readFile(varFile, &data.Vars); err != nil {
 					return err
@@ -54,6 +61,11 @@ func init() {
 		},
 
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if envFile != "" {
+				settings := config.GetSettings()
+
+				return importDotenv(cmd, settings.Profile.Context.Environment, envFileValues, envFileSecret, ignoreDuplicates)
+			}
 
 			if len(data.Vars) > 0 {
 				for key, value := range data.Vars {
@@ -81,6 +93,11 @@ func init() {
 	flags.StringVar(&secretFile, "secrets-file", secretFile, "File to import secrets from")
 	flags.BoolVarP(&ignoreDuplicates, "ignore-duplicates", "", false, "Skip variables that already exist in the environment")
 
+	flags.StringVar(&envFile, "file", envFile, "Create or update the variables of a .env file, quoted and multi-line values included")
+	flags.BoolVar(&envFileSecret, "secret", envFileSecret, "Set the variables of the --file as secrets")
+	command.MarkFlagsMutuallyExclusive("file", "vars-file")
+	command.MarkFlagsMutuallyExclusive("file", "secrets-file")
+
 	flags.AddFlag(options.Environment.AddFlagWithExtraHelp(
 		"environment",
 		"Environment for the variable",
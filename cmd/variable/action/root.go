@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 
+	"bunnyshell.com/cli/pkg/api/component_variable"
 	"bunnyshell.com/cli/pkg/build"
 	"bunnyshell.com/cli/pkg/config/option"
 	"github.com/spf13/cobra"
@@ -12,6 +13,9 @@ import (
 var (
 	errMissingValue        = errors.New("the plain value must be provided")
 	errMultipleValueInputs = errors.New("the value must be provided either by argument or by stdin, not both")
+	errMissingVariable     = errors.New("the variable must be given either by --id or by --name within an environment")
+	errVariableNotFound    = errors.New("variable not found")
+	errMissingEnvironment  = errors.New("the environment is required when using --name, given by --environment or the context")
 )
 
 var mainCmd = &cobra.Command{}
@@ -32,3 +36,51 @@ func GetIDOption(value *string) *option.String {
 
 	return idOption
 }
+
+// resolveVariableID returns the given id, or the id of the variable with the name within the environment.
+func resolveVariableID(id string, environmentID string, name string) (string, error) {
+	if id != "" {
+		return id, nil
+	}
+
+	if environmentID == "" {
+		return "", errMissingEnvironment
+	}
+
+	item, err := findVariable(environmentID, name)
+	if err != nil {
+		return "", err
+	}
+
+	if item == nil {
+		return "", fmt.Errorf("%w: %s", errVariableNotFound, name)
+	}
+
+	return item.GetId(), nil
+}
+
+// resolveComponentVariableID returns the given id, or the id of the variable with the name within the component.
+func resolveComponentVariableID(id string, componentID string, name string) (string, error) {
+	if id != "" {
+		return id, nil
+	}
+
+	listOptions := component_variable.NewListOptions()
+	listOptions.Component = componentID
+	listOptions.Name = name
+
+	model, err := component_variable.List(listOptions)
+	if err != nil {
+		return "", err
+	}
+
+	if model.HasEmbedded() {
+		for _, item := range model.Embedded.Item {
+			if item.GetName() == name {
+				return item.GetId(), nil
+			}
+		}
+	}
+
+	return "", fmt.Errorf("%w: %s", errVariableNotFound, name)
+}
@@ -0,0 +1,354 @@
+package action
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"bunnyshell.com/cli/pkg/api/variable"
+	"bunnyshell.com/cli/pkg/config"
+	"bunnyshell.com/cli/pkg/config/enum"
+	"bunnyshell.com/cli/pkg/lib"
+	"bunnyshell.com/cli/pkg/util"
+	"bunnyshell.com/sdk"
+	"github.com/spf13/cobra"
+)
+
+var (
+	errSetFailed           = errors.New("setting variables failed")
+	errSecretNotRestorable = errors.New("the API withholds the value of the secret variable, it cannot be restored in transactional mode")
+	errRollbackIncomplete  = errors.New("rollback incomplete, manual intervention required")
+	errValueWithoutName    = errors.New("--from-file and --base64 set a single variable, given by --name")
+	errMultipleSetInputs   = errors.New("the variables must be given either as NAME=value arguments, by --from-env-file or by --name, not several")
+)
+
+// stdinFile reads the value from stdin with --from-file.
+const stdinFile = "-"
+
+type SetOptions struct {
+	FromEnvFile string
+
+	Name     string
+	FromFile string
+	Base64   bool
+
+	IsSecret      bool
+	Transactional bool
+}
+
+// appliedChange holds what is needed to revert a variable to its state before "set".
+type appliedChange struct {
+	ID   string
+	Name string
+
+	Created bool
+
+	PreviousValue  string
+	PreviousSecret bool
+}
+
+func init() {
+	options := config.GetOptions()
+	settings := config.GetSettings()
+
+	setOptions := SetOptions{}
+
+	command := &cobra.Command{
+		Use: "set [NAME=value...]",
+
+		Short: "Create or update environment variables",
+		Long: "Create or update environment variables given as arguments or by an env file, or a single variable given by --name from a file or stdin.\n" +
+			"File values are kept as they are, newlines included, --base64 encodes binary content.\n" +
+			"With --transactional, already applied changes are rolled back when a variable fails to be set.",
+
+		Example: "set --environment EnvironmentID DEBUG=1 LOG_LEVEL=info\nset --from-env-file .env\nset --name TLS_CERT --from-file cert.pem --secret\ncat key.der | set --name TLS_KEY --from-file - --base64",
+
+		Args: lib.VariablePairs,
+
+		ValidArgsFunction: cobra.NoFileCompletions,
+
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 && (setOptions.FromEnvFile != "" || setOptions.Name != "") {
+				return errMultipleSetInputs
+			}
+
+			if setOptions.Name == "" && (setOptions.FromFile != "" || setOptions.Base64) {
+				return errValueWithoutName
+			}
+
+			if len(args) > 0 || setOptions.Name != "" {
+				return nil
+			}
+
+			return cmd.MarkFlagRequired("from-env-file")
+		},
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			values := map[string]string{}
+
+			switch {
+			case len(args) > 0:
+				for _, pair := range args {
+					name, value, _ := strings.Cut(pair, "=")
+					values[name] = value
+				}
+			case setOptions.Name != "":
+				value, err := readSetValue(setOptions.FromFile, setOptions.Base64)
+				if err != nil {
+					return err
+				}
+
+				values[setOptions.Name] = value
+			default:
+				if err := readDotenvFile(setOptions.FromEnvFile, values); err != nil {
+					return err
+				}
+			}
+
+			environmentID := settings.Profile.Context.Environment
+
+			existing, err := findExistingVariables(environmentID, values, setOptions.Transactional)
+			if err != nil {
+				return lib.FormatCommandError(cmd, err)
+			}
+
+			names := make([]string, 0, len(values))
+			for name := range values {
+				names = append(names, name)
+			}
+
+			sort.Strings(names)
+
+			changes := []appliedChange{}
+
+			for _, name := range names {
+				change, err := setVariable(environmentID, name, values[name], setOptions.IsSecret, existing[name])
+				if err == nil {
+					changes = append(changes, *change)
+
+					cmd.Printf("Variable %s successfully set\n", name)
+
+					continue
+				}
+
+				cmd.PrintErrf("Setting variable %s failed: %s\n", name, err)
+
+				if !setOptions.Transactional {
+					return fmt.Errorf("%w: %s", errSetFailed, name)
+				}
+
+				return rollbackChanges(cmd, changes)
+			}
+
+			return nil
+		},
+	}
+
+	flags := command.Flags()
+
+	flags.AddFlag(options.Environment.AddFlagWithExtraHelp(
+		"environment",
+		"Environment for the variables",
+		"Environments contain multiple variables",
+		util.FlagRequired,
+	))
+
+	flags.StringVar(&setOptions.FromEnvFile, "from-env-file", setOptions.FromEnvFile, "File to read the variables from")
+	util.AppendFlagHelp(flags.Lookup("from-env-file"), "The variables to create or update, in .env format")
+
+	flags.StringVar(&setOptions.Name, "name", setOptions.Name, "Name of a single variable to set, instead of an env file")
+	flags.StringVar(&setOptions.FromFile, "from-file", setOptions.FromFile, `File to read the value from, "-" or none for stdin`)
+	flags.BoolVar(&setOptions.Base64, "base64", setOptions.Base64, "Encode the value in base64, for binary content")
+	command.MarkFlagsMutuallyExclusive("from-env-file", "name")
+
+	flags.BoolVar(&setOptions.IsSecret, "secret", setOptions.IsSecret, "Set the variables as secrets")
+	flags.BoolVar(&setOptions.Transactional, "transactional", setOptions.Transactional, "Roll back applied changes if any variable fails to be set")
+
+	mainCmd.AddCommand(command)
+}
+
+// readSetValue reads the whole file or stdin, without trimming the trailing newlines.
+func readSetValue(file string, encode bool) (string, error) {
+	content, err := readSetContent(file)
+	if err != nil {
+		return "", err
+	}
+
+	if encode {
+		return base64.StdEncoding.EncodeToString(content), nil
+	}
+
+	return string(content), nil
+}
+
+func readSetContent(file string) ([]byte, error) {
+	if file != "" && file != stdinFile {
+		return os.ReadFile(file)
+	}
+
+	hasStdin, err := util.IsStdinPresent()
+	if err != nil {
+		return nil, err
+	}
+
+	if !hasStdin {
+		return nil, errMissingValue
+	}
+
+	return io.ReadAll(os.Stdin)
+}
+
+func findExistingVariables(
+	environmentID string,
+	values map[string]string,
+	transactional bool,
+) (map[string]*sdk.EnvironmentVariableItem, error) {
+	existing := map[string]*sdk.EnvironmentVariableItem{}
+
+	for name := range values {
+		item, err := findVariable(environmentID, name)
+		if err != nil {
+			return nil, err
+		}
+
+		if item == nil {
+			continue
+		}
+
+		if transactional && variable.IsValueWithheld(item) {
+			return nil, fmt.Errorf("%w: %s", errSecretNotRestorable, name)
+		}
+
+		existing[name] = item
+	}
+
+	return existing, nil
+}
+
+func findVariable(environmentID string, name string) (*sdk.EnvironmentVariableItem, error) {
+	listOptions := variable.NewListOptions()
+	listOptions.Environment = environmentID
+	listOptions.Name = name
+
+	model, err := variable.List(listOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	if !model.HasEmbedded() {
+		return nil, nil
+	}
+
+	for _, item := range model.Embedded.Item {
+		if item.GetName() == name {
+			return variable.Get(variable.NewItemOptions(item.GetId()))
+		}
+	}
+
+	return nil, nil
+}
+
+func setVariable(
+	environmentID string,
+	name string,
+	value string,
+	isSecret bool,
+	existing *sdk.EnvironmentVariableItem,
+) (*appliedChange, error) {
+	if existing == nil {
+		createOptions := variable.NewCreateOptions()
+		createOptions.Environment = environmentID
+		createOptions.Name = name
+		createOptions.Value = value
+
+		if isSecret {
+			createOptions.IsSecret = enum.BoolTrue
+		}
+
+		model, err := variable.Create(createOptions)
+		if err != nil {
+			return nil, err
+		}
+
+		return &appliedChange{
+			ID:      model.GetId(),
+			Name:    name,
+			Created: true,
+		}, nil
+	}
+
+	if _, err := editVariable(existing.GetId(), value, isSecret); err != nil {
+		return nil, err
+	}
+
+	return &appliedChange{
+		ID:   existing.GetId(),
+		Name: name,
+
+		PreviousValue:  existing.GetValue(),
+		PreviousSecret: existing.GetSecret(),
+	}, nil
+}
+
+func editVariable(id string, value string, isSecret bool) (*sdk.EnvironmentVariableItem, error) {
+	editOptions := variable.NewEditOptions(id)
+	editOptions.EnvironmentVariableEditAction.SetValue(value)
+
+	if isSecret {
+		editOptions.IsSecret = enum.BoolTrue
+	}
+
+	return variable.Edit(editOptions)
+}
+
+// restoreVariable sets back both the value and the secret flag, "set --secret" changing both.
+func restoreVariable(change appliedChange) (*sdk.EnvironmentVariableItem, error) {
+	editOptions := variable.NewEditOptions(change.ID)
+	editOptions.EnvironmentVariableEditAction.SetValue(change.PreviousValue)
+
+	editOptions.IsSecret = enum.BoolFalse
+	if change.PreviousSecret {
+		editOptions.IsSecret = enum.BoolTrue
+	}
+
+	return variable.Edit(editOptions)
+}
+
+func rollbackChanges(cmd *cobra.Command, changes []appliedChange) error {
+	failed := 0
+
+	for index := len(changes) - 1; index >= 0; index-- {
+		change := changes[index]
+
+		var err error
+
+		if change.Created {
+			deleteOptions := variable.NewDeleteOptions()
+			deleteOptions.ID = change.ID
+
+			err = variable.Delete(deleteOptions)
+		} else {
+			_, err = restoreVariable(change)
+		}
+
+		if err != nil {
+			failed++
+
+			cmd.PrintErrf("Rolling back variable %s failed: %s\n", change.Name, err)
+
+			continue
+		}
+
+		cmd.Printf("Variable %s rolled back\n", change.Name)
+	}
+
+	if failed != 0 {
+		return fmt.Errorf("%w: %d variable(s)", errRollbackIncomplete, failed)
+	}
+
+	return fmt.Errorf("%w: %d change(s) rolled back", errSetFailed, len(changes))
+}
@@ -0,0 +1,291 @@
+package action
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"bunnyshell.com/cli/pkg/api/variable"
+	"bunnyshell.com/cli/pkg/config"
+	"bunnyshell.com/cli/pkg/interactive"
+	"bunnyshell.com/cli/pkg/lib"
+	"bunnyshell.com/cli/pkg/util"
+	"bunnyshell.com/sdk"
+	"github.com/spf13/cobra"
+)
+
+const (
+	syncAdd    = "add"
+	syncUpdate = "update"
+	syncDelete = "delete"
+)
+
+const (
+	syncApplied = "applied"
+	syncFailed  = "failed"
+)
+
+var (
+	errSyncConfirmationRequired = errors.New("sync requires confirmation, use --yes in non-interactive mode")
+	errSyncFailed               = errors.New("some variables could not be synced")
+)
+
+type SyncChange struct {
+	Action string `json:"action" yaml:"action"`
+	Name   string `json:"name" yaml:"name"`
+
+	// Uncompared changes update secrets whose value the API withheld
+	Uncompared bool `json:"uncompared,omitempty" yaml:"uncompared,omitempty"`
+
+	existing *sdk.EnvironmentVariableItem
+	value    string
+}
+
+// SyncPlan lists the changes bringing the environment variables in line with the source file, values left out.
+type SyncPlan []SyncChange
+
+func (plan SyncPlan) Tabulate(w *tabwriter.Writer) {
+	if len(plan) == 0 {
+		fmt.Fprintln(w, "The environment variables are in sync.")
+
+		return
+	}
+
+	symbols := map[string]string{syncAdd: "+", syncUpdate: "~", syncDelete: "-"}
+
+	for _, change := range plan {
+		action := change.Action
+		if change.Uncompared {
+			action += " (secret, value not compared)"
+		}
+
+		fmt.Fprintf(w, "%v %v\t %v\n", symbols[change.Action], change.Name, action)
+	}
+}
+
+type SyncResult struct {
+	Action string `json:"action" yaml:"action"`
+	Name   string `json:"name" yaml:"name"`
+	Status string `json:"status" yaml:"status"`
+	Error  string `json:"error,omitempty" yaml:"error,omitempty"`
+}
+
+type SyncReport []SyncResult
+
+func (report SyncReport) Count(status string) int {
+	count := 0
+
+	for _, result := range report {
+		if result.Status == status {
+			count++
+		}
+	}
+
+	return count
+}
+
+func (report SyncReport) Tabulate(w *tabwriter.Writer) {
+	fmt.Fprintf(w, "%v\t %v\t %v\t %v\n", "Name", "Action", "Status", "Error")
+
+	for _, result := range report {
+		fmt.Fprintf(w, "%v\t %v\t %v\t %v\n", result.Name, result.Action, result.Status, result.Error)
+	}
+
+	fmt.Fprintf(w, "\n%d applied, %d failed\n", report.Count(syncApplied), report.Count(syncFailed))
+}
+
+func init() {
+	options := config.GetOptions()
+	settings := config.GetSettings()
+
+	fileName := ""
+	prune := false
+	isSecret := false
+	dryRun := false
+	yes := false
+
+	command := &cobra.Command{
+		Use: "sync",
+
+		Short: "Make the environment variables match a .env or JSON file",
+		Long: "Make the environment variables match a .env or JSON file, the source of truth.\n" +
+			"The changes are shown first and applied on confirmation, then the result of each change is reported.\n" +
+			"With --yes, only the report is output.\n" +
+			"Variables missing from the file are only deleted with --prune.\n" +
+			"Secrets whose value the API withholds cannot be compared, so they are always updated when found in the file.",
+
+		Example: "sync --environment EnvironmentID --file .env --prune\nsync --environment EnvironmentID --file vars.json --dry-run",
+
+		ValidArgsFunction: cobra.NoFileCompletions,
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			values, err := readSyncFile(fileName)
+			if err != nil {
+				return err
+			}
+
+			environmentID := settings.Profile.Context.Environment
+
+			plan, err := getSyncPlan(environmentID, values, prune)
+			if err != nil {
+				return lib.FormatCommandError(cmd, err)
+			}
+
+			// applying outputs the report, the plan is only shown when there is nothing to apply or to confirm it
+			if dryRun || len(plan) == 0 {
+				return lib.FormatCommandData(cmd, plan)
+			}
+
+			if !yes {
+				if err = lib.FormatCommandData(cmd, plan); err != nil {
+					return err
+				}
+
+				if settings.NonInteractive {
+					return errSyncConfirmationRequired
+				}
+
+				confirmed, err := interactive.Confirm(fmt.Sprintf("Apply %d change(s)?", len(plan)))
+				if err != nil {
+					return err
+				}
+
+				if !confirmed {
+					return nil
+				}
+			}
+
+			return applySyncPlan(cmd, environmentID, plan, isSecret)
+		},
+	}
+
+	flags := command.Flags()
+
+	flags.AddFlag(options.Environment.AddFlagWithExtraHelp(
+		"environment",
+		"Environment to sync the variables of",
+		"Environments contain multiple variables",
+		util.FlagRequired,
+	))
+
+	flags.StringVar(&fileName, "file", fileName, "The .env or .json file holding the variables")
+	util.MarkFlagRequiredWithHelp(flags.Lookup("file"), "The variables the environment should have, in .env format or as a JSON object")
+
+	flags.BoolVar(&prune, "prune", prune, "Delete the variables missing from the file")
+	flags.BoolVar(&isSecret, "secret", isSecret, "Set the added and updated variables as secrets")
+	flags.BoolVar(&dryRun, "dry-run", dryRun, "Only show the changes")
+	flags.BoolVar(&yes, "yes", yes, "Apply the changes without confirmation")
+	command.MarkFlagsMutuallyExclusive("dry-run", "yes")
+
+	mainCmd.AddCommand(command)
+}
+
+// readSyncFile reads JSON objects from .json files, dotenv otherwise.
+func readSyncFile(fileName string) (map[string]string, error) {
+	values := map[string]string{}
+
+	if !strings.EqualFold(filepath.Ext(fileName), ".json") {
+		return values, readDotenvFile(fileName, values)
+	}
+
+	content, err := os.ReadFile(fileName)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = json.Unmarshal(content, &values); err != nil {
+		return nil, fmt.Errorf("%s: %w", fileName, err)
+	}
+
+	return values, nil
+}
+
+func getSyncPlan(environmentID string, values map[string]string, prune bool) (SyncPlan, error) {
+	items, err := getVariableItems(environmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	existing := map[string]*sdk.EnvironmentVariableItem{}
+	for _, item := range items {
+		existing[item.GetName()] = item
+	}
+
+	plan := SyncPlan{}
+
+	for name, value := range values {
+		item, found := existing[name]
+
+		switch {
+		case !found:
+			plan = append(plan, SyncChange{Action: syncAdd, Name: name, value: value})
+		case variable.IsValueWithheld(item):
+			plan = append(plan, SyncChange{Action: syncUpdate, Name: name, Uncompared: true, value: value, existing: item})
+		case item.GetValue() != value:
+			plan = append(plan, SyncChange{Action: syncUpdate, Name: name, value: value, existing: item})
+		}
+	}
+
+	if prune {
+		for name, item := range existing {
+			if _, found := values[name]; !found {
+				plan = append(plan, SyncChange{Action: syncDelete, Name: name, existing: item})
+			}
+		}
+	}
+
+	sort.Slice(plan, func(i, j int) bool {
+		return plan[i].Name < plan[j].Name
+	})
+
+	return plan, nil
+}
+
+// applySyncPlan goes on when a change fails, reporting the failures at the end.
+func applySyncPlan(cmd *cobra.Command, environmentID string, plan SyncPlan, isSecret bool) error {
+	report := SyncReport{}
+
+	for _, change := range plan {
+		report = append(report, applySyncChange(environmentID, change, isSecret))
+	}
+
+	if err := lib.FormatCommandData(cmd, report); err != nil {
+		return err
+	}
+
+	if failed := report.Count(syncFailed); failed != 0 {
+		return fmt.Errorf("%w: %d variable(s)", errSyncFailed, failed)
+	}
+
+	return nil
+}
+
+func applySyncChange(environmentID string, change SyncChange, isSecret bool) SyncResult {
+	result := SyncResult{Action: change.Action, Name: change.Name}
+
+	var err error
+
+	if change.Action == syncDelete {
+		deleteOptions := variable.NewDeleteOptions()
+		deleteOptions.ID = change.existing.GetId()
+
+		err = variable.Delete(deleteOptions)
+	} else {
+		_, err = setVariable(environmentID, change.Name, change.value, isSecret, change.existing)
+	}
+
+	if err != nil {
+		result.Status, result.Error = syncFailed, err.Error()
+
+		return result
+	}
+
+	result.Status = syncApplied
+
+	return result
+}
@@ -1,27 +1,104 @@
 package variable
 
 import (
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"bunnyshell.com/cli/pkg/api/component_variable"
 	"bunnyshell.com/cli/pkg/api/variable"
 	"bunnyshell.com/cli/pkg/config"
 	"bunnyshell.com/cli/pkg/lib"
+	"bunnyshell.com/sdk"
 	"github.com/spf13/cobra"
 )
 
+const secretMask = "********"
+
+type VariableValue struct {
+	ID          string `json:"id" yaml:"id"`
+	Environment string `json:"environment" yaml:"environment"`
+	Name        string `json:"name" yaml:"name"`
+
+	Value  string `json:"value" yaml:"value"`
+	Secret bool   `json:"secret" yaml:"secret"`
+	Masked bool   `json:"masked" yaml:"masked"`
+}
+
+type VariableValues []VariableValue
+
+func (values VariableValues) Tabulate(w *tabwriter.Writer) {
+	fmt.Fprintf(w, "%v\t %v\t %v\t %v\t %v\n", "EnvVarID", "EnvironmentID", "Name", "Value", "Secret")
+
+	for _, item := range values {
+		fmt.Fprintf(w, "%v\t %v\t %v\t %v\t %v\n", item.ID, item.Environment, item.Name, item.Value, item.Secret)
+	}
+}
+
 func init() {
 	options := config.GetOptions()
 	settings := config.GetSettings()
 
 	listOptions := variable.NewListOptions()
+	withValues := false
+	showSecrets := false
+	scope := ""
+	componentID := ""
 
 	command := &cobra.Command{
 		Use: "list",
 
 		ValidArgsFunction: cobra.NoFileCompletions,
 
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return validateScope(scope)
+		},
+
 		RunE: func(cmd *cobra.Command, args []string) error {
 			listOptions.Organization = settings.Profile.Context.Organization
 			listOptions.Environment = settings.Profile.Context.Environment
 
+			// --name matches a part of the name, so the pages are filtered here instead of by the API
+			search := listOptions.Name
+			listOptions.Name = ""
+
+			if withValues || showSecrets {
+				values, err := getVariableValues(listOptions, search, showSecrets)
+				if err != nil {
+					return lib.FormatCommandError(cmd, err)
+				}
+
+				return lib.FormatCommandData(cmd, values)
+			}
+
+			if componentID != "" {
+				return listComponentVariables(cmd, listOptions, componentID, search)
+			}
+
+			if scope != "" {
+				variables, err := getScopedVariables(listOptions, scopedListOptions{
+					Scope:  scope,
+					Search: search,
+
+					Project:   settings.Profile.Context.Project,
+					Component: componentID,
+				})
+				if err != nil {
+					return lib.FormatCommandError(cmd, err)
+				}
+
+				return lib.FormatCommandData(cmd, variables)
+			}
+
+			if search != "" {
+				model, err := searchVariables(listOptions, search)
+				if err != nil {
+					return lib.FormatCommandError(cmd, err)
+				}
+
+				return lib.FormatCommandData(cmd, model)
+			}
+
 			return lib.ShowCollection(cmd, listOptions, func() (lib.ModelWithPagination, error) {
 				return variable.List(listOptions)
 			})
@@ -33,7 +110,170 @@ func init() {
 	flags.AddFlag(options.Organization.GetFlag("organization"))
 	flags.AddFlag(options.Environment.GetFlag("environment"))
 
+	flags.AddFlag(options.Project.GetFlag("project"))
+	// not the context component flag, which is marked as changed whenever the profile context has one
+	flags.StringVar(&componentID, "component", componentID, "List the variables of the component, instead of the environment")
+
 	listOptions.UpdateFlagSet(flags)
+	flags.Lookup("name").Usage = "Filter by a part of the name, ignoring case"
+
+	flags.StringVar(&scope, "scope", scope, "List the variables defined at another scope: "+strings.Join(variableScopes, ", "))
+
+	flags.BoolVar(&withValues, "values", withValues, "Include the variable values from all the listing pages, secret values masked")
+	flags.BoolVar(&showSecrets, "show-secrets", showSecrets, "Include the variable values with the secret values revealed, unless withheld by the API")
+
+	command.MarkFlagsMutuallyExclusive("component", "values")
+	command.MarkFlagsMutuallyExclusive("component", "show-secrets")
+	command.MarkFlagsMutuallyExclusive("component", "scope")
+	command.MarkFlagsMutuallyExclusive("scope", "values")
+	command.MarkFlagsMutuallyExclusive("scope", "show-secrets")
 
 	mainCmd.AddCommand(command)
 }
+
+func listComponentVariables(cmd *cobra.Command, listOptions *variable.ListOptions, componentID string, search string) error {
+	componentListOptions := component_variable.NewListOptions()
+	componentListOptions.Profile = listOptions.Profile
+	componentListOptions.Page = listOptions.Page
+	componentListOptions.Component = componentID
+
+	if search != "" {
+		model, err := searchComponentVariables(componentListOptions, search)
+		if err != nil {
+			return lib.FormatCommandError(cmd, err)
+		}
+
+		return lib.FormatCommandData(cmd, model)
+	}
+
+	return lib.ShowCollection(cmd, componentListOptions, func() (lib.ModelWithPagination, error) {
+		return component_variable.List(componentListOptions)
+	})
+}
+
+// searchVariables goes through all the listing pages, keeping the names matching the search in the first page.
+// The output keeps the shape of the API listing, as a single page holding all the matches.
+func searchVariables(listOptions *variable.ListOptions, search string) (*sdk.PaginatedEnvironmentVariableCollection, error) {
+	pageOptions := *listOptions
+	pageOptions.Page = 1
+
+	var result *sdk.PaginatedEnvironmentVariableCollection
+
+	items := []sdk.EnvironmentVariableCollection{}
+
+	for {
+		model, err := variable.List(&pageOptions)
+		if err != nil {
+			return nil, err
+		}
+
+		if result == nil {
+			result = model
+		}
+
+		if model.HasEmbedded() {
+			for _, item := range model.Embedded.Item {
+				if matchesSearch(item.GetName(), search) {
+					items = append(items, item)
+				}
+			}
+		}
+
+		if !model.HasLinks() || !model.Links.HasNext() {
+			break
+		}
+
+		pageOptions.Page++
+	}
+
+	if result.HasEmbedded() {
+		result.Embedded.Item = items
+	}
+
+	result.SetTotalItems(int32(len(items)))
+	result.Links = nil
+
+	return result, nil
+}
+
+// searchComponentVariables is searchVariables for the variables of a component.
+func searchComponentVariables(listOptions *component_variable.ListOptions, search string) (*sdk.PaginatedServiceComponentVariableCollection, error) {
+	pageOptions := *listOptions
+	pageOptions.Page = 1
+
+	var result *sdk.PaginatedServiceComponentVariableCollection
+
+	items := []sdk.ServiceComponentVariableCollection{}
+
+	for {
+		model, err := component_variable.List(&pageOptions)
+		if err != nil {
+			return nil, err
+		}
+
+		if result == nil {
+			result = model
+		}
+
+		if model.HasEmbedded() {
+			for _, item := range model.Embedded.Item {
+				if matchesSearch(item.GetName(), search) {
+					items = append(items, item)
+				}
+			}
+		}
+
+		if !model.HasLinks() || !model.Links.HasNext() {
+			break
+		}
+
+		pageOptions.Page++
+	}
+
+	if result.HasEmbedded() {
+		result.Embedded.Item = items
+	}
+
+	result.SetTotalItems(int32(len(items)))
+	result.Links = nil
+
+	return result, nil
+}
+
+// getVariableValues fetches each variable, the listing not including the values.
+func getVariableValues(listOptions *variable.ListOptions, search string, showSecrets bool) (VariableValues, error) {
+	items, err := variable.ListAll(*listOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	values := VariableValues{}
+
+	for _, item := range items {
+		if !matchesSearch(item.GetName(), search) {
+			continue
+		}
+
+		model, err := variable.Get(variable.NewItemOptions(item.GetId()))
+		if err != nil {
+			return nil, err
+		}
+
+		value := VariableValue{
+			ID:          model.GetId(),
+			Environment: model.GetEnvironment(),
+			Name:        model.GetName(),
+
+			Value:  model.GetValue(),
+			Secret: model.GetSecret(),
+		}
+
+		if value.Secret && (!showSecrets || variable.IsValueWithheld(model)) {
+			value.Value, value.Masked = secretMask, true
+		}
+
+		values = append(values, value)
+	}
+
+	return values, nil
+}
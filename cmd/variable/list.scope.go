@@ -0,0 +1,199 @@
+package variable
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"bunnyshell.com/cli/pkg/api/common"
+	"bunnyshell.com/cli/pkg/api/component_variable"
+	"bunnyshell.com/cli/pkg/api/environment"
+	"bunnyshell.com/cli/pkg/api/project_variable"
+	"bunnyshell.com/cli/pkg/api/variable"
+)
+
+const (
+	scopeEnvironment = "environment"
+	scopeComponent   = "component"
+	scopeProject     = "project"
+)
+
+var (
+	variableScopes = []string{scopeEnvironment, scopeComponent, scopeProject}
+
+	errUnknownScope   = errors.New("unknown variable scope")
+	errMissingProject = errors.New("the project scope needs a project, given by --project or --environment")
+)
+
+type ScopedVariable struct {
+	ID    string `json:"id" yaml:"id"`
+	Scope string `json:"scope" yaml:"scope"`
+	// Owner is the environment, component or project defining the variable
+	Owner string `json:"owner" yaml:"owner"`
+	Name  string `json:"name" yaml:"name"`
+}
+
+type ScopedVariables []ScopedVariable
+
+func (variables ScopedVariables) Tabulate(w *tabwriter.Writer) {
+	if len(variables) == 0 {
+		fmt.Fprintln(w, "No variables found.")
+
+		return
+	}
+
+	fmt.Fprintf(w, "%v\t %v\t %v\t %v\n", "VariableID", "Scope", "OwnerID", "Name")
+
+	for _, item := range variables {
+		fmt.Fprintf(w, "%v\t %v\t %v\t %v\n", item.ID, item.Scope, item.Owner, item.Name)
+	}
+}
+
+type scopedListOptions struct {
+	Scope  string
+	Search string
+
+	Project   string
+	Component string
+}
+
+func validateScope(scope string) error {
+	if scope == "" {
+		return nil
+	}
+
+	for _, known := range variableScopes {
+		if scope == known {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w %s, expected one of: %s", errUnknownScope, scope, strings.Join(variableScopes, ", "))
+}
+
+// matchesSearch tells whether the name contains the search, ignoring case.
+func matchesSearch(name string, search string) bool {
+	return strings.Contains(strings.ToLower(name), strings.ToLower(search))
+}
+
+// getScopedVariables goes through all the listing pages of the scope, keeping the names matching the search.
+func getScopedVariables(listOptions *variable.ListOptions, options scopedListOptions) (ScopedVariables, error) {
+	switch options.Scope {
+	case scopeComponent:
+		return getComponentScopedVariables(listOptions, options)
+	case scopeProject:
+		return getProjectScopedVariables(listOptions, options)
+	default:
+		return getEnvironmentScopedVariables(listOptions, options)
+	}
+}
+
+func getEnvironmentScopedVariables(listOptions *variable.ListOptions, options scopedListOptions) (ScopedVariables, error) {
+	items, err := variable.ListAll(*listOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	result := ScopedVariables{}
+
+	for _, item := range items {
+		if !matchesSearch(item.GetName(), options.Search) {
+			continue
+		}
+
+		result = append(result, ScopedVariable{
+			ID:    item.GetId(),
+			Scope: scopeEnvironment,
+			Owner: item.GetEnvironment(),
+			Name:  item.GetName(),
+		})
+	}
+
+	return result, nil
+}
+
+func getComponentScopedVariables(listOptions *variable.ListOptions, options scopedListOptions) (ScopedVariables, error) {
+	componentListOptions := component_variable.NewListOptions()
+	componentListOptions.Profile = listOptions.Profile
+	componentListOptions.Organization = listOptions.Organization
+	componentListOptions.Environment = listOptions.Environment
+	componentListOptions.Component = options.Component
+
+	items, err := component_variable.ListAll(*componentListOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	result := ScopedVariables{}
+
+	for _, item := range items {
+		if !matchesSearch(item.GetName(), options.Search) {
+			continue
+		}
+
+		result = append(result, ScopedVariable{
+			ID:    item.GetId(),
+			Scope: scopeComponent,
+			Owner: item.GetServiceComponent(),
+			Name:  item.GetName(),
+		})
+	}
+
+	return result, nil
+}
+
+func getProjectScopedVariables(listOptions *variable.ListOptions, options scopedListOptions) (ScopedVariables, error) {
+	projectID, err := getScopeProject(listOptions, options.Project)
+	if err != nil {
+		return nil, err
+	}
+
+	projectListOptions := project_variable.NewListOptions()
+	projectListOptions.Profile = listOptions.Profile
+	projectListOptions.Organization = listOptions.Organization
+	projectListOptions.Project = projectID
+
+	items, err := project_variable.ListAll(*projectListOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	result := ScopedVariables{}
+
+	for _, item := range items {
+		if !matchesSearch(item.GetName(), options.Search) {
+			continue
+		}
+
+		result = append(result, ScopedVariable{
+			ID:    item.GetId(),
+			Scope: scopeProject,
+			Owner: item.GetProject(),
+			Name:  item.GetName(),
+		})
+	}
+
+	return result, nil
+}
+
+// getScopeProject prefers the project of the environment, the variables it inherits, over the given project.
+func getScopeProject(listOptions *variable.ListOptions, projectID string) (string, error) {
+	if listOptions.Environment == "" {
+		if projectID == "" {
+			return "", errMissingProject
+		}
+
+		return projectID, nil
+	}
+
+	itemOptions := common.NewItemOptions(listOptions.Environment)
+	itemOptions.Profile = listOptions.Profile
+
+	model, err := environment.Get(itemOptions)
+	if err != nil {
+		return "", err
+	}
+
+	return model.GetProject(), nil
+}
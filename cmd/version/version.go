@@ -5,6 +5,8 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"runtime"
+	"sort"
 	"strings"
 
 	"bunnyshell.com/cli/pkg/build"
@@ -17,6 +19,19 @@ var errNotRedirect = errors.New("must be a redirect")
 
 var ClientOnly = false
 
+type Info struct {
+	Version       string `json:"version" yaml:"version"`
+	LatestVersion string `json:"latestVersion,omitempty" yaml:"latestVersion,omitempty"`
+
+	Commit    string `json:"commit" yaml:"commit"`
+	Date      string `json:"date" yaml:"date"`
+	GoVersion string `json:"goVersion" yaml:"goVersion"`
+	Platform  string `json:"platform" yaml:"platform"`
+
+	Tags     []string        `json:"tags" yaml:"tags"`
+	Features map[string]bool `json:"features" yaml:"features"`
+}
+
 var mainCmd = &cobra.Command{
 	Use: "version",
 
@@ -27,6 +42,22 @@ var mainCmd = &cobra.Command{
 
 	RunE: func(cmd *cobra.Command, args []string) error {
 		release := getCurrentRelease()
+		info := getInfo(release)
+
+		if !config.GetSettings().IsStylish() {
+			if !ClientOnly {
+				latestRelease, err := getLatestRelease()
+				if err != nil {
+					return lib.FormatCommandError(cmd, err)
+				}
+
+				info.LatestVersion = latestRelease
+			}
+
+			return lib.FormatCommandData(cmd, info)
+		}
+
+		defer printBuildInfo(cmd, info)
 
 		if ClientOnly {
 			cmd.Printf("You are using: %s\n", release)
@@ -73,6 +104,50 @@ func getCurrentRelease() string {
 	return "v" + build.Version
 }
 
+func getInfo(release string) *Info {
+	return &Info{
+		Version: release,
+
+		Commit:    build.Commit,
+		Date:      build.Date,
+		GoVersion: build.GetGoVersion(),
+		Platform:  runtime.GOOS + "/" + runtime.GOARCH,
+
+		Tags:     build.GetTags(),
+		Features: build.GetFeatures(),
+	}
+}
+
+func printBuildInfo(cmd *cobra.Command, info *Info) {
+	cmd.Printf("\nCommit: %s (%s)\n", info.Commit, info.Date)
+	cmd.Printf("Go: %s %s\n", info.GoVersion, info.Platform)
+
+	tags := "none"
+	if len(info.Tags) > 0 {
+		tags = strings.Join(info.Tags, ", ")
+	}
+
+	cmd.Printf("Build tags: %s\n", tags)
+
+	names := make([]string, 0, len(info.Features))
+	for name := range info.Features {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	cmd.Println("Features:")
+
+	for _, name := range names {
+		status := "disabled"
+		if info.Features[name] {
+			status = "enabled"
+		}
+
+		cmd.Printf("  %s: %s\n", name, status)
+	}
+}
+
 func getLatestRelease() (string, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), config.GetSettings().Timeout)
 	defer cancel()
@@ -20,4 +20,8 @@ func NewActionOptions(id string) *ActionOptions {
 
 func (ao *ActionOptions) UpdateFlagSet(flags *pflag.FlagSet) {
 	flags.BoolVar(&ao.WithoutPipeline, "no-wait", ao.WithoutPipeline, "Do not wait for pipeline until finish")
+
+	// alias kept out of the help, "--no-wait" remains the documented flag
+	flags.BoolVar(&ao.WithoutPipeline, "without-pipeline", ao.WithoutPipeline, "Do not wait for pipeline until finish")
+	_ = flags.MarkHidden("without-pipeline")
 }
@@ -12,6 +12,7 @@ type PartialActionOptions struct {
 	ActionOptions
 
 	components []string
+	partial    bool
 
 	flags *pflag.FlagSet
 }
@@ -44,9 +45,26 @@ func (pao *PartialActionOptions) GetActionComponents() []string {
 }
 
 func (pao *PartialActionOptions) IsPartial() bool {
+	if pao.partial {
+		return true
+	}
+
 	if pao.flags == nil {
 		return false
 	}
 
 	return pao.flags.Lookup(componentVarName).Changed
 }
+
+// UseComponentsOf makes the action partial on the same components as source, when source is.
+func (pao *PartialActionOptions) UseComponentsOf(source *PartialActionOptions) {
+	pao.components = source.components
+	pao.partial = source.partial
+	pao.flags = source.flags
+}
+
+// SetComponents makes the action partial on the given components, regardless of the flags.
+func (pao *PartialActionOptions) SetComponents(components ...string) {
+	pao.components = components
+	pao.partial = true
+}
@@ -0,0 +1,75 @@
+package component
+
+import (
+	"strings"
+
+	"bunnyshell.com/cli/pkg/api/project"
+	"bunnyshell.com/sdk"
+	"github.com/spf13/pflag"
+)
+
+type AggregateOptions struct {
+	ListOptions
+
+	Search string
+
+	Concurrency int
+}
+
+func NewAggregateOptions() *AggregateOptions {
+	return &AggregateOptions{
+		ListOptions: *NewListOptions(),
+
+		Concurrency: project.DefaultAggregateConcurrency,
+	}
+}
+
+func (ao *AggregateOptions) UpdateFlagSet(flags *pflag.FlagSet) {
+	flags.StringVar(&ao.Search, "search", ao.Search, "Search by name, case insensitive, across all the listing pages")
+	flags.IntVar(&ao.Concurrency, "concurrency", ao.Concurrency, "Number of projects listed in parallel when aggregating")
+}
+
+// Matches reports the component name contains the searched text.
+func (ao *AggregateOptions) Matches(item sdk.ComponentCollection) bool {
+	return strings.Contains(strings.ToLower(item.GetName()), strings.ToLower(ao.Search))
+}
+
+// Filter keeps the components matching the search, all of them when there is none.
+func (ao *AggregateOptions) Filter(items []sdk.ComponentCollection) []sdk.ComponentCollection {
+	if ao.Search == "" {
+		return items
+	}
+
+	result := []sdk.ComponentCollection{}
+
+	for _, item := range items {
+		if ao.Matches(item) {
+			result = append(result, item)
+		}
+	}
+
+	return result
+}
+
+// AggregateProjects lists the components of every project within the organization.
+// Projects which fail to list are reported instead of failing the whole aggregation.
+func AggregateProjects(options *AggregateOptions) ([]sdk.ComponentCollection, []project.ProjectError, error) {
+	listOptions := project.NewListOptions()
+	listOptions.Profile = options.Profile
+	listOptions.Organization = options.Organization
+
+	items, failures, err := project.Aggregate(listOptions, options.Concurrency, func(projectID string) ([]sdk.ComponentCollection, error) {
+		return listProjectComponents(options.ListOptions, projectID)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return options.Filter(items), failures, nil
+}
+
+func listProjectComponents(listOptions ListOptions, projectID string) ([]sdk.ComponentCollection, error) {
+	listOptions.Project = projectID
+
+	return ListAll(listOptions)
+}
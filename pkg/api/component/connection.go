@@ -0,0 +1,237 @@
+package component
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"bunnyshell.com/cli/pkg/api/common"
+	"bunnyshell.com/cli/pkg/api/component_variable"
+	"bunnyshell.com/sdk"
+	"github.com/spf13/pflag"
+)
+
+const secretMask = "********"
+
+var (
+	usernameSuffixes = []string{"USERNAME", "USER"}
+	passwordSuffixes = []string{"PASSWORD", "PASS"}
+	databaseSuffixes = []string{"DATABASE_NAME", "DB_NAME", "DATABASE", "DB"}
+	hostSuffixes     = []string{"HOSTNAME", "HOST"}
+	portSuffixes     = []string{"PORT"}
+	urlSuffixes      = []string{"URL", "URI", "DSN"}
+)
+
+type ConnectionItem struct {
+	Component string `json:"component" yaml:"component"`
+
+	Scheme   string `json:"scheme,omitempty" yaml:"scheme,omitempty"`
+	Host     string `json:"host,omitempty" yaml:"host,omitempty"`
+	Port     string `json:"port,omitempty" yaml:"port,omitempty"`
+	Username string `json:"username,omitempty" yaml:"username,omitempty"`
+	Password string `json:"password,omitempty" yaml:"password,omitempty"`
+	Database string `json:"database,omitempty" yaml:"database,omitempty"`
+
+	ConnectionString string `json:"connectionString" yaml:"connectionString"`
+}
+
+// Tabulate only writes the connection string, so the stylish output can be used as is.
+func (item *ConnectionItem) Tabulate(w *tabwriter.Writer) {
+	fmt.Fprintln(w, item.ConnectionString)
+}
+
+type ConnectionOptions struct {
+	common.ItemOptions
+
+	Scheme string
+
+	ShowSecrets bool
+}
+
+func NewConnectionOptions(id string) *ConnectionOptions {
+	return &ConnectionOptions{
+		ItemOptions: *common.NewItemOptions(id),
+	}
+}
+
+func (co *ConnectionOptions) UpdateFlagSet(flags *pflag.FlagSet) {
+	flags.StringVar(&co.Scheme, "scheme", co.Scheme, "Connection string scheme (eg: postgres, mysql, redis)")
+	flags.BoolVar(&co.ShowSecrets, "show-secrets", co.ShowSecrets, "Show credentials instead of masking them")
+}
+
+func Connection(options *ConnectionOptions) (*ConnectionItem, error) {
+	model, err := Get(&options.ItemOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	variables, err := componentVariables(options)
+	if err != nil {
+		return nil, err
+	}
+
+	connection := newConnectionItem(model, variables)
+
+	if options.Scheme != "" {
+		connection.Scheme = options.Scheme
+	}
+
+	if !options.ShowSecrets && connection.Password != "" {
+		connection.Password = secretMask
+	}
+
+	connection.ConnectionString = connection.String()
+
+	return connection, nil
+}
+
+func (ci *ConnectionItem) String() string {
+	connectionURL := url.URL{
+		Scheme: ci.Scheme,
+		Host:   ci.Host,
+	}
+
+	if ci.Port != "" {
+		connectionURL.Host = net.JoinHostPort(ci.Host, ci.Port)
+	}
+
+	if ci.Username != "" {
+		if ci.Password != "" {
+			connectionURL.User = url.UserPassword(ci.Username, ci.Password)
+		} else {
+			connectionURL.User = url.User(ci.Username)
+		}
+	}
+
+	if ci.Database != "" {
+		connectionURL.Path = "/" + ci.Database
+	}
+
+	if connectionURL.Scheme == "" {
+		connectionURL.Scheme = "tcp"
+	}
+
+	return connectionURL.String()
+}
+
+func newConnectionItem(model *sdk.ComponentItem, variables map[string]string) *ConnectionItem {
+	connection := &ConnectionItem{
+		Component: model.GetName(),
+
+		Host: model.GetName(),
+	}
+
+	for _, publicURL := range model.GetPublicURLs() {
+		if applyURL(connection, publicURL) {
+			break
+		}
+	}
+
+	if value, ok := findBySuffix(variables, urlSuffixes); ok {
+		applyURL(connection, value)
+	}
+
+	if value, ok := findBySuffix(variables, hostSuffixes); ok {
+		connection.Host = value
+	}
+
+	if value, ok := findBySuffix(variables, portSuffixes); ok {
+		connection.Port = value
+	}
+
+	if value, ok := findBySuffix(variables, usernameSuffixes); ok {
+		connection.Username = value
+	}
+
+	if value, ok := findBySuffix(variables, passwordSuffixes); ok {
+		connection.Password = value
+	}
+
+	if value, ok := findBySuffix(variables, databaseSuffixes); ok {
+		connection.Database = value
+	}
+
+	return connection
+}
+
+func applyURL(connection *ConnectionItem, value string) bool {
+	parsed, err := url.Parse(value)
+	if err != nil || parsed.Scheme == "" || parsed.Host == "" {
+		return false
+	}
+
+	connection.Scheme = parsed.Scheme
+	connection.Host = parsed.Hostname()
+	connection.Port = parsed.Port()
+
+	if parsed.User != nil {
+		connection.Username = parsed.User.Username()
+
+		if password, ok := parsed.User.Password(); ok {
+			connection.Password = password
+		}
+	}
+
+	if database := strings.Trim(parsed.Path, "/"); database != "" {
+		connection.Database = database
+	}
+
+	return true
+}
+
+func findBySuffix(variables map[string]string, suffixes []string) (string, bool) {
+	names := make([]string, 0, len(variables))
+	for name := range variables {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, suffix := range suffixes {
+		for _, name := range names {
+			if name == suffix || strings.HasSuffix(name, "_"+suffix) {
+				return variables[name], true
+			}
+		}
+	}
+
+	return "", false
+}
+
+func componentVariables(options *ConnectionOptions) (map[string]string, error) {
+	variables := map[string]string{}
+
+	listOptions := component_variable.NewListOptions()
+	listOptions.Profile = options.Profile
+	listOptions.Component = options.ID
+
+	for {
+		model, err := component_variable.List(listOptions)
+		if err != nil {
+			return nil, err
+		}
+
+		if model.HasEmbedded() {
+			for _, item := range model.Embedded.Item {
+				itemOptions := component_variable.NewItemOptions(item.GetId())
+				itemOptions.Profile = options.Profile
+
+				variable, err := component_variable.Get(itemOptions)
+				if err != nil {
+					return nil, err
+				}
+
+				variables[strings.ToUpper(variable.GetName())] = variable.GetValue()
+			}
+		}
+
+		if !model.HasLinks() || !model.Links.HasNext() {
+			return variables, nil
+		}
+
+		listOptions.Page++
+	}
+}
@@ -31,7 +31,11 @@ func NewListOptions() *ListOptions {
 func (lo *ListOptions) UpdateFlagSet(flags *pflag.FlagSet) {
 	flags.StringVar(&lo.ClusterStatus, "clusterStatus", lo.ClusterStatus, "Filter by Cluster Status")
 	flags.StringVar(&lo.OperationStatus, "operationStatus", lo.OperationStatus, "Filter by Operation Status")
-	flags.StringVar(&lo.Name, "componentName", lo.OperationStatus, "Filter by Name")
+	flags.StringVar(&lo.Name, "componentName", lo.Name, "Filter by Name")
+
+	flags.StringVar(&lo.ClusterStatus, "status", lo.ClusterStatus, "Filter by status (e.g. running, stopped)")
+	flags.StringVar(&lo.OperationStatus, "operation-status", lo.OperationStatus, "Filter by Operation Status")
+	flags.StringVar(&lo.Name, "name", lo.Name, "Filter by names containing the given text")
 
 	lo.ListOptions.UpdateFlagSet(flags)
 }
@@ -91,3 +95,27 @@ func applyOptions(request sdk.ApiComponentListRequest, options *ListOptions) sdk
 
 	return request
 }
+
+// ListAll goes through all the pages of the listing.
+func ListAll(listOptions ListOptions) ([]sdk.ComponentCollection, error) {
+	result := []sdk.ComponentCollection{}
+
+	listOptions.Page = 1
+
+	for {
+		model, err := List(&listOptions)
+		if err != nil {
+			return nil, err
+		}
+
+		if model.HasEmbedded() {
+			result = append(result, model.Embedded.Item...)
+		}
+
+		if !model.HasLinks() || !model.Links.HasNext() {
+			return result, nil
+		}
+
+		listOptions.Page++
+	}
+}
@@ -86,3 +86,27 @@ func applyOptions(request sdk.ApiServiceComponentVariableListRequest, options *L
 
 	return request
 }
+
+// ListAll goes through all the pages of the listing.
+func ListAll(listOptions ListOptions) ([]sdk.ServiceComponentVariableCollection, error) {
+	result := []sdk.ServiceComponentVariableCollection{}
+
+	listOptions.Page = 1
+
+	for {
+		model, err := List(&listOptions)
+		if err != nil {
+			return nil, err
+		}
+
+		if model.HasEmbedded() {
+			result = append(result, model.Embedded.Item...)
+		}
+
+		if !model.HasLinks() || !model.Links.HasNext() {
+			return result, nil
+		}
+
+		listOptions.Page++
+	}
+}
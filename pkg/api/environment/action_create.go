@@ -5,6 +5,7 @@ import (
 	"net/http"
 
 	"bunnyshell.com/cli/pkg/api"
+	"bunnyshell.com/cli/pkg/api/k8s"
 	"bunnyshell.com/cli/pkg/lib"
 	"bunnyshell.com/cli/pkg/util"
 	"bunnyshell.com/sdk"
@@ -12,6 +13,8 @@ import (
 	"github.com/spf13/cobra"
 )
 
+var errWatchRequiresDeploy = errors.New("--watch-only-on-failure requires --deploy")
+
 type CreateOptions struct {
 	DeployOptions
 
@@ -20,6 +23,11 @@ type CreateOptions struct {
 	genesisSourceOptions GenesisSourceOptions
 
 	WithDeploy bool
+
+	WatchOnlyOnFailure bool
+
+	IfNotExists bool
+	Upsert      bool
 }
 
 func NewCreateOptions() *CreateOptions {
@@ -48,6 +56,10 @@ func (co *CreateOptions) UpdateCommandFlags(command *cobra.Command) {
 
 	flags.StringVar(&co.Name, "name", co.Name, "Unique name for the environment")
 	flags.BoolVar(&co.WithDeploy, "deploy", co.WithDeploy, "Deploy the environment after creation")
+	flags.BoolVar(&co.WatchOnlyOnFailure, "watch-only-on-failure", co.WatchOnlyOnFailure, "Hide the deployment progress and only show the failed pipeline stages with a link to the pipeline if it fails, the job logs are not available through the API")
+	flags.BoolVar(&co.IfNotExists, "if-not-exists", co.IfNotExists, "Return the existing environment with the same name in the project instead of failing")
+	flags.BoolVar(&co.Upsert, "upsert", co.Upsert, "Update the configuration of the existing environment with the same name in the project instead of failing")
+	command.MarkFlagsMutuallyExclusive("if-not-exists", "upsert")
 	flags.StringVar(k8sIntegration, "k8s", *k8sIntegration, "Use a Kubernetes integration for the environment")
 
 	util.MarkFlagRequiredWithHelp(flags.Lookup("name"), "A unique name within the project for the new environment")
@@ -61,16 +73,24 @@ func (co *CreateOptions) UpdateCommandFlags(command *cobra.Command) {
 	flags.BoolVar(co.TerminationProtection, "termination-protection", *co.TerminationProtection, "Prevent environment from being accidentally terminated")
 	flags.StringVar(ephemeralsK8sIntegration, "ephemerals-k8s", *ephemeralsK8sIntegration, "The Kubernetes integration to be used for the ephemeral environments triggered by this environment")
 
+	k8s.RegisterFlagCompletion(command, "k8s", "ephemerals-k8s")
+
 	co.DeployOptions.UpdateFlagSet(flags)
 
 	co.genesisSourceOptions.updateCommandFlags(command, "creation")
 }
 
 func (co *CreateOptions) Validate() error {
+	if co.WatchOnlyOnFailure && !co.WithDeploy {
+		return errWatchRequiresDeploy
+	}
+
 	return co.genesisSourceOptions.validate()
 }
 
 func (co *CreateOptions) AttachGenesis() error {
+	co.genesisSourceOptions.environmentName = co.Name
+
 	fromGit, fromGitSpec, fromString, fromTemplate, err := co.genesisSourceOptions.getGenesis()
 	if err != nil {
 		return err
@@ -86,6 +106,31 @@ func (co *CreateOptions) AttachGenesis() error {
 	return nil
 }
 
+// ToEditConfigurationOptions reuses the attached genesis and deploy settings to update an existing environment.
+func (co *CreateOptions) ToEditConfigurationOptions(environment string) *EditConfigurationOptions {
+	editConfigurationOptions := NewEditConfigurationOptions(environment)
+	editConfigurationOptions.DeployOptions = co.DeployOptions
+	editConfigurationOptions.DeployOptions.ID = environment
+	editConfigurationOptions.WithDeploy = co.WithDeploy
+	editConfigurationOptions.K8SIntegration = co.GetKubernetesIntegration()
+	editConfigurationOptions.genesisSourceOptions = co.genesisSourceOptions
+
+	if co.Genesis != nil {
+		editConfigurationOptions.Configuration = &sdk.EnvironmentEditConfigurationConfiguration{
+			FromGit:      co.Genesis.FromGit,
+			FromGitSpec:  co.Genesis.FromGitSpec,
+			FromTemplate: co.Genesis.FromTemplate,
+			FromString:   co.Genesis.FromString,
+		}
+	}
+
+	return editConfigurationOptions
+}
+
+func (co *CreateOptions) ComposeSkipped() []string {
+	return co.genesisSourceOptions.ComposeSkipped()
+}
+
 func (co *CreateOptions) HandleError(cmd *cobra.Command, err error) error {
 	var apiError api.Error
 
@@ -5,6 +5,7 @@ import (
 	"net/http"
 
 	"bunnyshell.com/cli/pkg/api"
+	"bunnyshell.com/cli/pkg/api/k8s"
 	"bunnyshell.com/cli/pkg/lib"
 	"bunnyshell.com/sdk"
 	"github.com/spf13/cobra"
@@ -44,6 +45,7 @@ func (eco *EditConfigurationOptions) UpdateCommandFlags(command *cobra.Command)
 	data := &eco.EditConfigurationData
 
 	flags.StringVar(&data.K8SIntegration, "k8s", data.K8SIntegration, "Set Kubernetes integration for the environment (if not set)")
+	k8s.RegisterFlagCompletion(command, "k8s")
 
 	flags.BoolVar(&eco.WithDeploy, "deploy", eco.WithDeploy, "Deploy the environment after update")
 
@@ -72,6 +74,10 @@ func (eco *EditConfigurationOptions) AttachGenesis() error {
 	return nil
 }
 
+func (eco *EditConfigurationOptions) ComposeSkipped() []string {
+	return eco.genesisSourceOptions.ComposeSkipped()
+}
+
 func (eco *EditConfigurationOptions) HandleError(cmd *cobra.Command, err error) error {
 	var apiError api.Error
 
@@ -1,18 +1,28 @@
 package environment
 
 import (
+	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"io"
+	"net/http"
+	"net/url"
 	"os"
 	"strings"
 
 	"bunnyshell.com/cli/pkg/api"
 	"bunnyshell.com/cli/pkg/api/template"
+	"bunnyshell.com/cli/pkg/compose"
+	"bunnyshell.com/cli/pkg/config"
+	gitHelper "bunnyshell.com/cli/pkg/helper/git"
+	"bunnyshell.com/cli/pkg/interactive"
 	"bunnyshell.com/cli/pkg/lib"
+	"bunnyshell.com/cli/pkg/net"
 	"bunnyshell.com/sdk"
 	"github.com/spf13/cast"
 	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
 )
 
 type GenesisSourceOptions struct {
@@ -23,18 +33,44 @@ type GenesisSourceOptions struct {
 
 	YamlPath string
 
+	ComposePath string
+
+	URL        string
+	URLHeaders []string
+
 	GitRepo   string
 	GitBranch string
 	GitPath   string
+
+	CurrentRepo bool
+
+	VariablePairs []string
+
+	environmentName string
+	composeSkipped  []string
 }
 
-const variableSplitSize = 2
+const (
+	variableSplitSize = 2
+
+	stdinFileName = "-"
+
+	definitionIndent = 2
+)
 
 var (
-	errGenesisSourceNotProvided = errors.New("template id, content or git repository must be provided")
+	errGenesisSourceNotProvided = errors.New("template id, content, compose file or git repository must be provided")
 	errInvalidVarDefinition     = errors.New("invalid template variable definition")
 	errUnknownVar               = errors.New("unknown variable")
 	errUnknownEnum              = errors.New("unknown enum value")
+	errVarsRequireYaml          = errors.New("--var can only be used with --from-path, --from-compose or --from-url")
+	errHeadersRequireURL        = errors.New("--from-url-header requires --from-url")
+	errInvalidURLHeader         = errors.New("invalid header, expected Name: value")
+	errInvalidURL               = errors.New("invalid --from-url")
+	errUnsupportedURLScheme     = errors.New("only http and https URLs are supported")
+	errDownloadFailed           = errors.New("downloading the definition failed")
+	errInvalidDefinitionYaml    = errors.New("environment definition must be a yaml mapping")
+	errMissingTemplateVars      = errors.New("missing required template variables")
 )
 
 func NewGenesisSourceOptions() *GenesisSourceOptions {
@@ -52,7 +88,19 @@ func (gs *GenesisSourceOptions) updateCommandFlags(command *cobra.Command, genes
 		"Template variables to use during environment "+genesis,
 	)
 
-	flags.StringVar(&gs.YamlPath, "from-path", gs.YamlPath, "Use a local bunnyshell.yaml during environment "+genesis)
+	flags.StringVar(&gs.YamlPath, "from-path", gs.YamlPath, "Use a local bunnyshell.yaml during environment "+genesis+` ("-" reads from stdin)`)
+
+	flags.StringArrayVar(
+		&gs.VariablePairs,
+		"var",
+		gs.VariablePairs,
+		"Override environment variables (KEY=VALUE) during environment "+genesis,
+	)
+
+	flags.StringVar(&gs.ComposePath, "from-compose", gs.ComposePath, "Convert a local docker-compose.yml during environment "+genesis)
+
+	flags.StringVar(&gs.URL, "from-url", gs.URL, "Download the bunnyshell.yaml from a http(s) URL during environment "+genesis)
+	flags.StringArrayVar(&gs.URLHeaders, "from-url-header", gs.URLHeaders, `Header sent when downloading --from-url (e.g. "Authorization: Bearer <token>")`)
 
 	flags.StringVar(&gs.Git, "from-git", gs.Git, "Use a template git repository during environment "+genesis)
 
@@ -60,18 +108,31 @@ func (gs *GenesisSourceOptions) updateCommandFlags(command *cobra.Command, genes
 	flags.StringVar(&gs.GitBranch, "from-git-branch", gs.GitBranch, "Git branch for the environment template")
 	flags.StringVar(&gs.GitPath, "from-git-path", gs.GitPath, "Git path for the environment template")
 
-	command.MarkFlagsMutuallyExclusive("from-git", "from-template", "from-path", "from-git-repo")
+	flags.BoolVar(&gs.CurrentRepo, "from-current-repo", gs.CurrentRepo, "Use the git repository and branch of the current directory during environment "+genesis)
+
+	command.MarkFlagsMutuallyExclusive("from-git", "from-template", "from-path", "from-compose", "from-git-repo", "from-current-repo", "from-url")
+	command.MarkFlagsMutuallyExclusive("from-git-branch", "from-current-repo")
+	command.MarkFlagsMutuallyExclusive("from-git-path", "from-current-repo")
 	command.MarkFlagsRequiredTogether("from-git-branch", "from-git-repo")
 	command.MarkFlagsRequiredTogether("from-git-path", "from-git-repo")
 
 	_ = command.MarkFlagFilename("from-path", "yaml", "yml")
+	_ = command.MarkFlagFilename("from-compose", "yaml", "yml")
 }
 
 func (gs *GenesisSourceOptions) validate() error {
-	if gs.Git == "" && gs.TemplateID == "" && gs.YamlPath == "" && gs.GitRepo == "" {
+	if gs.Git == "" && gs.TemplateID == "" && gs.YamlPath == "" && gs.ComposePath == "" && gs.GitRepo == "" && !gs.CurrentRepo && gs.URL == "" {
 		return errGenesisSourceNotProvided
 	}
 
+	if len(gs.VariablePairs) > 0 && gs.YamlPath == "" && gs.ComposePath == "" && gs.URL == "" {
+		return errVarsRequireYaml
+	}
+
+	if len(gs.URLHeaders) > 0 && gs.URL == "" {
+		return errHeadersRequireURL
+	}
+
 	return nil
 }
 func (gs *GenesisSourceOptions) handleError(cmd *cobra.Command, apiError api.Error) error {
@@ -101,6 +162,18 @@ func (gs *GenesisSourceOptions) getGenesisName() string {
 		return "--from-path"
 	}
 
+	if gs.ComposePath != "" {
+		return "--from-compose"
+	}
+
+	if gs.CurrentRepo {
+		return "--from-current-repo"
+	}
+
+	if gs.URL != "" {
+		return "--from-url"
+	}
+
 	return "arguments"
 }
 
@@ -109,6 +182,12 @@ func (gs *GenesisSourceOptions) getGenesis() (*sdk.FromGit, *sdk.FromGitSpec, *s
 		return nil, gs.getFromGitSpec(), nil, nil, nil
 	}
 
+	if gs.CurrentRepo {
+		if err := gs.loadCurrentRepo(); err != nil {
+			return nil, nil, nil, nil, err
+		}
+	}
+
 	if gs.GitRepo != "" {
 		return gs.getFromGit(), nil, nil, nil, nil
 	}
@@ -131,6 +210,24 @@ func (gs *GenesisSourceOptions) getGenesis() (*sdk.FromGit, *sdk.FromGitSpec, *s
 		return nil, nil, fromString, nil, nil
 	}
 
+	if gs.ComposePath != "" {
+		fromString, err := gs.getFromCompose()
+		if err != nil {
+			return nil, nil, nil, nil, err
+		}
+
+		return nil, nil, fromString, nil, nil
+	}
+
+	if gs.URL != "" {
+		fromString, err := gs.getFromURL()
+		if err != nil {
+			return nil, nil, nil, nil, err
+		}
+
+		return nil, nil, fromString, nil, nil
+	}
+
 	return nil, nil, nil, nil, errGenesisSourceNotProvided
 }
 
@@ -144,6 +241,19 @@ func (gs *GenesisSourceOptions) getFromGit() *sdk.FromGit {
 
 }
 
+func (gs *GenesisSourceOptions) loadCurrentRepo() error {
+	repository, err := gitHelper.DetectLocalRepository(".")
+	if err != nil {
+		return err
+	}
+
+	gs.GitRepo = repository.URL
+	gs.GitBranch = repository.Branch
+	gs.GitPath = repository.YamlPath
+
+	return nil
+}
+
 func (gs *GenesisSourceOptions) getFromGitSpec() *sdk.FromGitSpec {
 	fromGitSpec := sdk.NewFromGitSpec()
 	fromGitSpec.Spec = &gs.Git
@@ -159,38 +269,293 @@ func (gs *GenesisSourceOptions) getFromString() (*sdk.FromString, error) {
 		return nil, err
 	}
 
+	if bytes, err = overrideVariables(bytes, gs.VariablePairs); err != nil {
+		return nil, fmt.Errorf("%s: %w", gs.YamlPath, err)
+	}
+
+	content := string(bytes)
+	fromString.Yaml = &content
+
+	return fromString, nil
+}
+
+func (gs *GenesisSourceOptions) getFromCompose() (*sdk.FromString, error) {
+	bytes, err := readFile(gs.ComposePath)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := compose.Convert(bytes, gs.environmentName)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", gs.ComposePath, err)
+	}
+
+	gs.composeSkipped = result.Skipped
+
+	manifest, err := overrideVariables(result.Manifest, gs.VariablePairs)
+	if err != nil {
+		return nil, err
+	}
+
+	content := string(manifest)
+
+	fromString := sdk.NewFromString()
+	fromString.Yaml = &content
+
+	return fromString, nil
+}
+
+func (gs *GenesisSourceOptions) getFromURL() (*sdk.FromString, error) {
+	bytes, err := downloadFile(gs.URL, gs.URLHeaders)
+	if err != nil {
+		return nil, err
+	}
+
+	if bytes, err = overrideVariables(bytes, gs.VariablePairs); err != nil {
+		return nil, fmt.Errorf("--from-url: %w", err)
+	}
+
 	content := string(bytes)
+
+	fromString := sdk.NewFromString()
 	fromString.Yaml = &content
 
 	return fromString, nil
 }
 
+// ComposeSkipped lists the compose constructs which could not be converted.
+func (gs *GenesisSourceOptions) ComposeSkipped() []string {
+	return gs.composeSkipped
+}
+
 func (gs *GenesisSourceOptions) getFromTemplate() (*sdk.FromTemplate, error) {
 	fromTemplate := sdk.NewFromTemplate()
 	fromTemplate.Template = &gs.TemplateID
 
-	if len(gs.TemplateVariablePairs) > 0 {
-		templateVariablesSchema, schemaError := getTemplateVariableSchema(gs.TemplateID)
-		if schemaError != nil {
-			return nil, schemaError
+	templateVariablesSchema, err := getTemplateVariableSchema(gs.TemplateID)
+	if err != nil {
+		return nil, err
+	}
+
+	variables := map[string]sdk.FromTemplateVariablesValue{}
+	for _, pair := range gs.TemplateVariablePairs {
+		name, value, err := parseDefinition(pair, templateVariablesSchema)
+		if err != nil {
+			return nil, err
 		}
 
-		variables := map[string]sdk.FromTemplateVariablesValue{}
-		for _, pair := range gs.TemplateVariablePairs {
-			name, value, err := parseDefinition(pair, templateVariablesSchema)
-			if err != nil {
-				return nil, err
-			}
+		variables[*name] = *value
+	}
 
-			variables[*name] = *value
-		}
+	if err = askMissingTemplateVariables(variables, templateVariablesSchema); err != nil {
+		return nil, err
+	}
 
+	if len(variables) > 0 {
 		fromTemplate.SetVariables(variables)
 	}
 
 	return fromTemplate, nil
 }
 
+// askMissingTemplateVariables prompts for the variables without a default value, failing when not interactive.
+func askMissingTemplateVariables(
+	variables map[string]sdk.FromTemplateVariablesValue,
+	templateVariablesSchema []sdk.TemplateItemVariablesSchemaInner,
+) error {
+	missing := []templateVariable{}
+
+	for _, variable := range getTemplateVariables(templateVariablesSchema) {
+		if _, found := variables[variable.name]; found || variable.hasDefault {
+			continue
+		}
+
+		missing = append(missing, variable)
+	}
+
+	if len(missing) == 0 {
+		return nil
+	}
+
+	if config.GetSettings().NonInteractive {
+		names := make([]string, len(missing))
+		for index, variable := range missing {
+			names[index] = variable.name
+		}
+
+		return fmt.Errorf(
+			"%w: %s, provide them with --template-var name=value",
+			errMissingTemplateVars,
+			strings.Join(names, ", "),
+		)
+	}
+
+	for _, variable := range missing {
+		answer, err := variable.ask()
+		if err != nil {
+			return err
+		}
+
+		value, err := getVariableValue(variable.name, answer, templateVariablesSchema)
+		if err != nil {
+			return err
+		}
+
+		variables[variable.name] = *value
+	}
+
+	return nil
+}
+
+type templateVariable struct {
+	name        string
+	description string
+	hasDefault  bool
+
+	choices []string
+}
+
+func (variable templateVariable) ask() (string, error) {
+	question := "Template variable " + variable.name
+
+	if len(variable.choices) > 0 {
+		_, answer, err := interactive.Choose(question, variable.choices)
+
+		return answer, err
+	}
+
+	return interactive.AskWithHelp(question, variable.description, interactive.AssertMinimumLength(1))
+}
+
+func getTemplateVariables(templateVariablesSchema []sdk.TemplateItemVariablesSchemaInner) []templateVariable {
+	result := []templateVariable{}
+
+	for _, schema := range templateVariablesSchema {
+		switch {
+		case schema.BooleanTypeItem != nil:
+			item := schema.BooleanTypeItem
+			result = append(result, templateVariable{
+				name:        item.GetName(),
+				description: item.GetDescription(),
+				hasDefault:  item.HasDefaultValue(),
+				choices:     []string{"true", "false"},
+			})
+		case schema.IntegerTypeItem != nil:
+			item := schema.IntegerTypeItem
+			result = append(result, templateVariable{
+				name:        item.GetName(),
+				description: item.GetDescription(),
+				hasDefault:  item.HasDefaultValue(),
+			})
+		case schema.FloatTypeItem != nil:
+			item := schema.FloatTypeItem
+			result = append(result, templateVariable{
+				name:        item.GetName(),
+				description: item.GetDescription(),
+				hasDefault:  item.HasDefaultValue(),
+			})
+		case schema.StringTypeItem != nil:
+			item := schema.StringTypeItem
+			result = append(result, templateVariable{
+				name:        item.GetName(),
+				description: item.GetDescription(),
+				hasDefault:  item.HasDefaultValue(),
+			})
+		case schema.EnumTypeItem != nil:
+			item := schema.EnumTypeItem
+			_, hasDefault := item.GetDefaultValueOk()
+			result = append(result, templateVariable{
+				name:        item.GetName(),
+				description: item.GetDescription(),
+				hasDefault:  hasDefault,
+				choices:     getEnumChoices(item.GetValues()),
+			})
+		}
+	}
+
+	return result
+}
+
+func getEnumChoices(values []sdk.EnumTypeItemValuesInner) []string {
+	choices := []string{}
+
+	for _, item := range values {
+		switch {
+		case item.BooleanValueItem != nil:
+			choices = append(choices, cast.ToString(item.BooleanValueItem.GetValue()))
+		case item.IntegerValueItem != nil:
+			choices = append(choices, cast.ToString(item.IntegerValueItem.GetValue()))
+		case item.FloatValueItem != nil:
+			choices = append(choices, cast.ToString(item.FloatValueItem.GetValue()))
+		case item.StringValueItem != nil:
+			choices = append(choices, item.StringValueItem.GetValue())
+		}
+	}
+
+	return choices
+}
+
+// overrideVariables sets the environmentVariables of the definition, adding the section when missing.
+func overrideVariables(content []byte, pairs []string) ([]byte, error) {
+	if len(pairs) == 0 {
+		return content, nil
+	}
+
+	document := yaml.Node{}
+	if err := yaml.Unmarshal(content, &document); err != nil {
+		return nil, err
+	}
+
+	if len(document.Content) == 0 || document.Content[0].Kind != yaml.MappingNode {
+		return nil, errInvalidDefinitionYaml
+	}
+
+	variables := getMappingValue(document.Content[0], "environmentVariables")
+	if variables.Kind != yaml.MappingNode {
+		// an empty "environmentVariables:" is parsed as null
+		variables.Kind, variables.Tag, variables.Value = yaml.MappingNode, "!!map", ""
+	}
+
+	for _, pair := range pairs {
+		name, value, found := strings.Cut(pair, "=")
+		if !found {
+			return nil, fmt.Errorf("%w: %s", errInvalidVarDefinition, pair)
+		}
+
+		variable := getMappingValue(variables, name)
+		variable.Kind, variable.Tag, variable.Value, variable.Style, variable.Content = yaml.ScalarNode, "!!str", value, 0, nil
+	}
+
+	var buffer bytes.Buffer
+
+	encoder := yaml.NewEncoder(&buffer)
+	encoder.SetIndent(definitionIndent)
+
+	if err := encoder.Encode(&document); err != nil {
+		return nil, err
+	}
+
+	if err := encoder.Close(); err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
+
+func getMappingValue(mapping *yaml.Node, key string) *yaml.Node {
+	for index := 0; index+1 < len(mapping.Content); index += 2 {
+		if mapping.Content[index].Value == key {
+			return mapping.Content[index+1]
+		}
+	}
+
+	value := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+
+	mapping.Content = append(mapping.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: key}, value)
+
+	return value
+}
+
 func getTemplateVariableSchema(templateID string) ([]sdk.TemplateItemVariablesSchemaInner, error) {
 	templateItem, err := template.Get(template.NewItemOptions(templateID))
 	if err != nil {
@@ -299,6 +664,10 @@ func stringToEnumValue(stringValue string, values []sdk.EnumTypeItemValuesInner)
 }
 
 func readFile(fileName string) ([]byte, error) {
+	if fileName == stdinFileName {
+		return io.ReadAll(os.Stdin)
+	}
+
 	file, err := os.Open(fileName)
 	if err != nil {
 		return nil, err
@@ -308,3 +677,50 @@ func readFile(fileName string) ([]byte, error) {
 
 	return io.ReadAll(file)
 }
+
+func downloadFile(fileURL string, headers []string) ([]byte, error) {
+	// the URL may hold credentials, so the errors only show its scheme or host
+	info, err := url.Parse(fileURL)
+	if err != nil {
+		return nil, errInvalidURL
+	}
+
+	if info.Scheme != "http" && info.Scheme != "https" {
+		return nil, fmt.Errorf("%w, got %q", errUnsupportedURLScheme, info.Scheme)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), config.GetSettings().Timeout)
+	defer cancel()
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, fileURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, header := range headers {
+		name, value, found := strings.Cut(header, ":")
+		if !found || strings.TrimSpace(name) == "" {
+			return nil, fmt.Errorf("%w: %s", errInvalidURLHeader, header)
+		}
+
+		request.Header.Add(strings.TrimSpace(name), strings.TrimSpace(value))
+	}
+
+	response, err := net.GetCLIClient().Do(request)
+	if err != nil {
+		var urlError *url.Error
+		if errors.As(err, &urlError) {
+			err = urlError.Err
+		}
+
+		return nil, fmt.Errorf("%w: %s: %w", errDownloadFailed, info.Host, err)
+	}
+
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w: %s responded with %s", errDownloadFailed, info.Host, response.Status)
+	}
+
+	return io.ReadAll(response.Body)
+}
@@ -0,0 +1,92 @@
+package environment
+
+import (
+	"bunnyshell.com/cli/pkg/api/project"
+	"bunnyshell.com/sdk"
+	"github.com/spf13/pflag"
+)
+
+type AggregateOptions struct {
+	ListOptions
+
+	Concurrency int
+}
+
+func NewAggregateOptions() *AggregateOptions {
+	return &AggregateOptions{
+		ListOptions: *NewListOptions(),
+
+		Concurrency: project.DefaultAggregateConcurrency,
+	}
+}
+
+func (ao *AggregateOptions) UpdateFlagSet(flags *pflag.FlagSet) {
+	flags.IntVar(&ao.Concurrency, "concurrency", ao.Concurrency, "Number of projects listed in parallel when aggregating")
+}
+
+// AggregateProjects lists the environments of every project within the organization.
+// Projects which fail to list are reported instead of failing the whole aggregation.
+func AggregateProjects(options *AggregateOptions) ([]sdk.EnvironmentCollection, []project.ProjectError, error) {
+	listOptions := project.NewListOptions()
+	listOptions.Profile = options.Profile
+	listOptions.Organization = options.Organization
+
+	items, failures, err := project.Aggregate(listOptions, options.Concurrency, func(projectID string) ([]sdk.EnvironmentCollection, error) {
+		return listProjectEnvironments(options.ListOptions, projectID)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return items, failures, nil
+}
+
+func listProjectEnvironments(listOptions ListOptions, projectID string) ([]sdk.EnvironmentCollection, error) {
+	listOptions.Project = projectID
+
+	return ListAll(listOptions)
+}
+
+// ListAll goes through all the pages of the listing.
+func ListAll(listOptions ListOptions) ([]sdk.EnvironmentCollection, error) {
+	result := []sdk.EnvironmentCollection{}
+
+	listOptions.Page = 1
+
+	for {
+		model, err := List(&listOptions)
+		if err != nil {
+			return nil, err
+		}
+
+		if model.HasEmbedded() {
+			result = append(result, model.Embedded.Item...)
+		}
+
+		if !model.HasLinks() || !model.Links.HasNext() {
+			return result, nil
+		}
+
+		listOptions.Page++
+	}
+}
+
+// FindByName returns the environment with the exact name within the project, or nil when there is none.
+func FindByName(projectID string, name string) (*sdk.EnvironmentCollection, error) {
+	listOptions := NewListOptions()
+	listOptions.Project = projectID
+	listOptions.Search = name
+
+	environments, err := ListAll(*listOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range environments {
+		if item.GetName() == name {
+			return &item, nil
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,144 @@
+package environment
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"text/tabwriter"
+)
+
+type GraphNode struct {
+	Name string `json:"name" yaml:"name"`
+	Kind string `json:"kind,omitempty" yaml:"kind,omitempty"`
+}
+
+type GraphEdge struct {
+	From string `json:"from" yaml:"from"`
+	To   string `json:"to" yaml:"to"`
+}
+
+type GraphItem struct {
+	Nodes []GraphNode `json:"nodes" yaml:"nodes"`
+	Edges []GraphEdge `json:"edges" yaml:"edges"`
+}
+
+type GraphOptions struct {
+	DefinitionOptions
+}
+
+func NewGraphOptions(id string) *GraphOptions {
+	return &GraphOptions{
+		DefinitionOptions: *NewDefinitionOptions(id),
+	}
+}
+
+func Graph(options *GraphOptions) (*GraphItem, error) {
+	definition, err := Definition(&options.DefinitionOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewGraphFromDefinition(definition.Data), nil
+}
+
+// NewGraphFromDefinition builds the component graph from the "dependsOn" declarations.
+// Edges point from a component to the components it depends on.
+func NewGraphFromDefinition(data DefinitionData) *GraphItem {
+	graph := &GraphItem{
+		Nodes: []GraphNode{},
+		Edges: []GraphEdge{},
+	}
+
+	components, _ := data["components"].([]any)
+
+	known := map[string]bool{}
+
+	for _, item := range components {
+		component, ok := item.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		name, _ := component["name"].(string)
+		if name == "" {
+			continue
+		}
+
+		kind, _ := component["kind"].(string)
+
+		graph.Nodes = append(graph.Nodes, GraphNode{
+			Name: name,
+			Kind: kind,
+		})
+		known[name] = true
+
+		dependencies, _ := component["dependsOn"].([]any)
+		for _, dependency := range dependencies {
+			if dependencyName, ok := dependency.(string); ok && dependencyName != "" {
+				graph.Edges = append(graph.Edges, GraphEdge{
+					From: name,
+					To:   dependencyName,
+				})
+			}
+		}
+	}
+
+	// dependencies on components outside of the definition are still shown
+	for _, edge := range graph.Edges {
+		if !known[edge.To] {
+			graph.Nodes = append(graph.Nodes, GraphNode{Name: edge.To})
+			known[edge.To] = true
+		}
+	}
+
+	sort.SliceStable(graph.Nodes, func(i, j int) bool {
+		return graph.Nodes[i].Name < graph.Nodes[j].Name
+	})
+
+	sort.SliceStable(graph.Edges, func(i, j int) bool {
+		if graph.Edges[i].From == graph.Edges[j].From {
+			return graph.Edges[i].To < graph.Edges[j].To
+		}
+
+		return graph.Edges[i].From < graph.Edges[j].From
+	})
+
+	return graph
+}
+
+// Tabulate writes the DOT format, the stylish output being meant for graphviz.
+func (graph *GraphItem) Tabulate(w *tabwriter.Writer) {
+	fmt.Fprintln(w, graph.DOT())
+}
+
+func (graph *GraphItem) DOT() string {
+	var builder strings.Builder
+
+	builder.WriteString("digraph environment {\n")
+	builder.WriteString("  rankdir=LR;\n")
+	builder.WriteString("  node [shape=box];\n")
+
+	for _, node := range graph.Nodes {
+		if node.Kind == "" {
+			fmt.Fprintf(&builder, "  %s;\n", dotQuote(node.Name))
+
+			continue
+		}
+
+		fmt.Fprintf(&builder, "  %s [label=%s];\n", dotQuote(node.Name), dotQuote(node.Name+"\n"+node.Kind))
+	}
+
+	for _, edge := range graph.Edges {
+		fmt.Fprintf(&builder, "  %s -> %s;\n", dotQuote(edge.From), dotQuote(edge.To))
+	}
+
+	builder.WriteString("}")
+
+	return builder.String()
+}
+
+func dotQuote(value string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+	return `"` + replacer.Replace(value) + `"`
+}
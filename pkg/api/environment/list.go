@@ -36,6 +36,9 @@ func (lo *ListOptions) UpdateFlagSet(flags *pflag.FlagSet) {
 	flags.StringVar(&lo.Type, "type", lo.Type, "Filter by Type")
 	flags.StringVar(&lo.ClusterStatus, "clusterStatus", lo.ClusterStatus, "Filter by Cluster Status")
 	flags.StringVar(&lo.OperationStatus, "operationStatus", lo.OperationStatus, "Filter by Operation Status")
+
+	flags.StringVar(&lo.ClusterStatus, "status", lo.ClusterStatus, "Filter by status (e.g. running, stopped, draft)")
+	flags.StringVar(&lo.OperationStatus, "operation-status", lo.OperationStatus, "Filter by Operation Status")
 	flags.StringVar(&lo.KubernetesIntegration, "k8sCluster", lo.KubernetesIntegration, "Filter by K8SIntegrationID")
 	flags.StringVar(&lo.Search, "search", lo.Search, "Search by name")
 
@@ -1,6 +1,7 @@
 package api
 
 import (
+	"errors"
 	"fmt"
 	"net"
 	"net/http"
@@ -13,12 +14,25 @@ type Error struct {
 	Detail string `json:"detail" yaml:"detail"`
 
 	Violations []sdk.ProblemViolation `json:"violations" yaml:"violations"`
+
+	statusCode int
 }
 
 func (pe Error) Error() string {
 	return pe.Title + ": " + pe.Detail
 }
 
+// StatusCode is the HTTP status of the response, 0 when none was received.
+func (pe Error) StatusCode() int {
+	return pe.statusCode
+}
+
+func IsNotFound(err error) bool {
+	var apiError Error
+
+	return errors.As(err, &apiError) && apiError.statusCode == http.StatusNotFound
+}
+
 func ParseError(resp *http.Response, err error) error {
 	switch err := err.(type) {
 	case net.Error:
@@ -38,6 +52,8 @@ func ParseError(resp *http.Response, err error) error {
 				Detail: *problem.Detail,
 
 				Violations: problem.Violations,
+
+				statusCode: getStatusCode(resp),
 			}
 		}
 	}
@@ -51,5 +67,15 @@ func ParseError(resp *http.Response, err error) error {
 		Detail: err.Error(),
 
 		Violations: nil,
+
+		statusCode: resp.StatusCode,
+	}
+}
+
+func getStatusCode(resp *http.Response) int {
+	if resp == nil {
+		return 0
 	}
+
+	return resp.StatusCode
 }
@@ -18,6 +18,9 @@ type ListOptions struct {
 
 	Type   string
 	Status string
+
+	PageSize int32
+	All      bool
 }
 
 func NewListOptions() *ListOptions {
@@ -30,6 +33,9 @@ func (lo *ListOptions) UpdateFlagSet(flags *pflag.FlagSet) {
 	flags.StringVar(&lo.Type, "type", lo.Type, "Filter by Type")
 	flags.StringVar(&lo.Status, "status", lo.Status, "Filter by Status")
 
+	flags.Int32Var(&lo.PageSize, "page-size", lo.PageSize, "Number of events per listing page")
+	flags.BoolVar(&lo.All, "all", lo.All, "List the events from all pages")
+
 	lo.ListOptions.UpdateFlagSet(flags)
 }
 
@@ -75,7 +81,7 @@ func applyOptions(request sdk.ApiEventListRequest, options *ListOptions) sdk.Api
 	}
 
 	if options.Status != "" {
-		request.Status(options.Status)
+		request = request.Status(options.Status)
 	}
 
 	return request
@@ -0,0 +1,121 @@
+package event
+
+import (
+	"bunnyshell.com/sdk"
+)
+
+// ListPage fetches the API pages overlapping the requested page of options.PageSize events.
+// The result keeps the layout of an API page, with the requested page and size.
+func ListPage(options *ListOptions) (*sdk.PaginatedEventCollection, error) {
+	pageOptions := *options
+	pageOptions.Page = 1
+
+	page, err := List(&pageOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := listPageItems(&pageOptions, page, int((options.Page-1)*options.PageSize), int(options.PageSize))
+	if err != nil {
+		return nil, err
+	}
+
+	setPageItems(page, items)
+	page.SetPage(options.Page)
+	page.SetItemsPerPage(options.PageSize)
+
+	return page, nil
+}
+
+// listPageItems collects size events from offset, starting with the already fetched model.
+func listPageItems(pageOptions *ListOptions, model *sdk.PaginatedEventCollection, offset int, size int) ([]sdk.EventCollection, error) {
+	items := []sdk.EventCollection{}
+
+	apiPageSize := int(model.GetItemsPerPage())
+	if apiPageSize < 1 {
+		return items, nil
+	}
+
+	var err error
+
+	for apiPage := offset/apiPageSize + 1; len(items) < size; apiPage++ {
+		if model.GetPage() != int32(apiPage) {
+			pageOptions.Page = int32(apiPage)
+
+			if model, err = List(pageOptions); err != nil {
+				return nil, err
+			}
+		}
+
+		if !model.HasEmbedded() {
+			break
+		}
+
+		pageItems := model.Embedded.Item
+
+		skip := offset + len(items) - (apiPage-1)*apiPageSize
+		if skip >= len(pageItems) {
+			break
+		}
+
+		pageItems = pageItems[skip:]
+		if remaining := size - len(items); len(pageItems) > remaining {
+			pageItems = pageItems[:remaining]
+		}
+
+		items = append(items, pageItems...)
+
+		if !model.HasLinks() || !model.Links.HasNext() {
+			break
+		}
+	}
+
+	return items, nil
+}
+
+// ListAll goes through all the pages of the listing, returned as a single page.
+func ListAll(options *ListOptions) (*sdk.PaginatedEventCollection, error) {
+	pageOptions := *options
+	pageOptions.Page = 1
+
+	var page *sdk.PaginatedEventCollection
+
+	items := []sdk.EventCollection{}
+
+	for {
+		model, err := List(&pageOptions)
+		if err != nil {
+			return nil, err
+		}
+
+		if page == nil {
+			page = model
+		}
+
+		if model.HasEmbedded() {
+			items = append(items, model.Embedded.Item...)
+		}
+
+		if !model.HasLinks() || !model.Links.HasNext() {
+			break
+		}
+
+		pageOptions.Page++
+	}
+
+	setPageItems(page, items)
+	page.SetPage(1)
+	page.SetItemsPerPage(int32(len(items)))
+	page.SetTotalItems(int32(len(items)))
+
+	return page, nil
+}
+
+// setPageItems replaces the items of the first API page, dropping its links which no longer apply.
+func setPageItems(page *sdk.PaginatedEventCollection, items []sdk.EventCollection) {
+	if page.HasEmbedded() {
+		page.Embedded.Item = items
+	}
+
+	page.Links = nil
+}
@@ -0,0 +1,42 @@
+package k8s
+
+import (
+	"fmt"
+
+	"bunnyshell.com/cli/pkg/config"
+	"github.com/spf13/cobra"
+)
+
+// RegisterFlagCompletion completes the named flags of the command with Kubernetes integration ids.
+func RegisterFlagCompletion(command *cobra.Command, names ...string) {
+	for _, name := range names {
+		_ = command.RegisterFlagCompletionFunc(name, CompleteIDs)
+	}
+}
+
+// CompleteIDs suggests the Kubernetes integrations of the organization, described by their cluster.
+func CompleteIDs(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	listOptions := NewListOptions()
+	listOptions.Organization = config.GetSettings().Profile.Context.Organization
+
+	completions := []string{}
+
+	for {
+		model, err := List(listOptions)
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveError | cobra.ShellCompDirectiveNoFileComp
+		}
+
+		if model.HasEmbedded() {
+			for _, item := range model.Embedded.Item {
+				completions = append(completions, fmt.Sprintf("%s\t%s (%s)", item.GetId(), item.GetClusterName(), item.GetCloudProvider()))
+			}
+		}
+
+		if !model.HasLinks() || !model.Links.HasNext() {
+			return completions, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		listOptions.Page++
+	}
+}
@@ -63,3 +63,27 @@ func applyOptions(request sdk.ApiOrganizationListRequest, options *ListOptions)
 
 	return request
 }
+
+// ListAll goes through all the pages of the listing.
+func ListAll(listOptions ListOptions) ([]sdk.OrganizationCollection, error) {
+	result := []sdk.OrganizationCollection{}
+
+	listOptions.Page = 1
+
+	for {
+		model, err := List(&listOptions)
+		if err != nil {
+			return nil, err
+		}
+
+		if model.HasEmbedded() {
+			result = append(result, model.Embedded.Item...)
+		}
+
+		if !model.HasLinks() || !model.Links.HasNext() {
+			return result, nil
+		}
+
+		listOptions.Page++
+	}
+}
@@ -0,0 +1,155 @@
+package project
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"text/tabwriter"
+
+	"bunnyshell.com/cli/pkg/config"
+	"bunnyshell.com/cli/pkg/formatter"
+	"bunnyshell.com/cli/pkg/lib"
+	"bunnyshell.com/cli/pkg/net"
+	"github.com/spf13/cobra"
+)
+
+const DefaultAggregateConcurrency = 4
+
+var ErrPartialAggregate = errors.New("some projects could not be listed")
+
+type ProjectError struct {
+	Project string `json:"project" yaml:"project"`
+	Error   string `json:"error" yaml:"error"`
+}
+
+// AggregateListing holds the data listed across the projects next to the projects which could not be listed.
+// It is output even without failures, so structured output keeps a single shape.
+type AggregateListing struct {
+	Items any `json:"items" yaml:"items"`
+
+	Failures []ProjectError `json:"failures" yaml:"failures"`
+}
+
+func (listing *AggregateListing) Tabulate(w *tabwriter.Writer) {
+	formatter.WriteStylish(w, listing.Items)
+
+	if len(listing.Failures) == 0 {
+		return
+	}
+
+	fmt.Fprintf(w, "\n%v\t %v\n", "FailedProjectID", "Error")
+
+	for _, failure := range listing.Failures {
+		fmt.Fprintf(w, "%v\t %v\n", failure.Project, failure.Error)
+	}
+}
+
+// ShowAggregateListing outputs the listing, failing when some projects could not be listed.
+// The oneline output has no structure to keep, the failures follow the items as text.
+func ShowAggregateListing(cmd *cobra.Command, listing *AggregateListing) error {
+	var err error
+
+	if config.GetSettings().OutputFormat == config.OnelineFormat {
+		err = lib.FormatCommandData(cmd, listing.Items)
+
+		for _, failure := range listing.Failures {
+			cmd.Printf("Could not list project %s: %s\n", failure.Project, failure.Error)
+		}
+	} else {
+		err = lib.FormatCommandData(cmd, listing)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if len(listing.Failures) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("%w: %d project(s) failed", ErrPartialAggregate, len(listing.Failures))
+}
+
+// Aggregate calls listProject for every project within the organization, at most concurrency at a time.
+// Projects which fail to list are reported next to the items instead of failing the whole aggregation.
+func Aggregate[T any](listOptions *ListOptions, concurrency int, listProject func(projectID string) ([]T, error)) ([]T, []ProjectError, error) {
+	resume := net.PauseSpinner()
+	defer resume()
+
+	spinner := net.MakeSpinner()
+
+	spinner.Start()
+	defer spinner.Stop()
+
+	projects, err := listIDs(listOptions)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	items := make([][]T, len(projects))
+	errs := make([]error, len(projects))
+
+	semaphore := make(chan struct{}, concurrency)
+
+	var wg sync.WaitGroup
+
+	for index, projectID := range projects {
+		wg.Add(1)
+
+		go func(index int, projectID string) {
+			defer wg.Done()
+
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			items[index], errs[index] = listProject(projectID)
+		}(index, projectID)
+	}
+
+	wg.Wait()
+
+	result := []T{}
+	failures := []ProjectError{}
+
+	for index, projectID := range projects {
+		if errs[index] != nil {
+			failures = append(failures, ProjectError{
+				Project: projectID,
+				Error:   errs[index].Error(),
+			})
+
+			continue
+		}
+
+		result = append(result, items[index]...)
+	}
+
+	return result, failures, nil
+}
+
+func listIDs(listOptions *ListOptions) ([]string, error) {
+	projects := []string{}
+
+	for {
+		model, err := List(listOptions)
+		if err != nil {
+			return nil, err
+		}
+
+		if model.HasEmbedded() {
+			for _, item := range model.Embedded.Item {
+				projects = append(projects, item.GetId())
+			}
+		}
+
+		if !model.HasLinks() || !model.Links.HasNext() {
+			return projects, nil
+		}
+
+		listOptions.Page++
+	}
+}
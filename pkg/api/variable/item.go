@@ -22,6 +22,11 @@ func Get(options *common.ItemOptions) (*sdk.EnvironmentVariableItem, error) {
 	return model, nil
 }
 
+// IsValueWithheld reports whether the API left out the value of a secret variable.
+func IsValueWithheld(item *sdk.EnvironmentVariableItem) bool {
+	return item.GetSecret() && item.GetValue() == ""
+}
+
 func GetRaw(options *common.ItemOptions) (*sdk.EnvironmentVariableItem, *http.Response, error) {
 	profile := options.GetProfile()
 
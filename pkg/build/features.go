@@ -0,0 +1,52 @@
+package build
+
+import (
+	"runtime/debug"
+	"sort"
+	"strings"
+)
+
+// GetFeatures reports the build settings changing the binary capabilities, there are no optional features behind build tags.
+func GetFeatures() map[string]bool {
+	return map[string]bool{
+		"cgo": getBuildSetting("CGO_ENABLED") == "1",
+	}
+}
+
+func GetTags() []string {
+	tags := []string{}
+
+	for _, tag := range strings.Split(getBuildSetting("-tags"), ",") {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+
+	sort.Strings(tags)
+
+	return tags
+}
+
+func GetGoVersion() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return "unknown"
+	}
+
+	return info.GoVersion
+}
+
+func getBuildSetting(key string) string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return ""
+	}
+
+	for _, setting := range info.Settings {
+		if setting.Key == key {
+			return setting.Value
+		}
+	}
+
+	return ""
+}
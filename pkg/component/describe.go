@@ -0,0 +1,172 @@
+package component
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"bunnyshell.com/cli/pkg/api"
+	componentGit "bunnyshell.com/cli/pkg/api/component/git"
+	"bunnyshell.com/cli/pkg/formatter"
+	"bunnyshell.com/cli/pkg/k8s"
+	bunnysdk "bunnyshell.com/sdk"
+	coreV1 "k8s.io/api/core/v1"
+)
+
+type ComponentDescription struct {
+	Component *bunnysdk.ComponentItem `json:"component" yaml:"component"`
+
+	// Git is empty for components not built from a repository
+	Git *bunnysdk.ComponentGitItem `json:"git,omitempty" yaml:"git,omitempty"`
+
+	Workloads []WorkloadDescription `json:"workloads" yaml:"workloads"`
+	Pods      PodStatuses           `json:"pods" yaml:"pods"`
+}
+
+type WorkloadDescription struct {
+	Resource string `json:"resource" yaml:"resource"`
+
+	Replicas      int32 `json:"replicas" yaml:"replicas"`
+	ReadyReplicas int32 `json:"readyReplicas" yaml:"readyReplicas"`
+
+	Containers []ContainerDescription `json:"containers" yaml:"containers"`
+}
+
+type ContainerDescription struct {
+	Name  string `json:"name" yaml:"name"`
+	Image string `json:"image" yaml:"image"`
+
+	Requests map[string]string `json:"requests,omitempty" yaml:"requests,omitempty"`
+	Limits   map[string]string `json:"limits,omitempty" yaml:"limits,omitempty"`
+}
+
+// Tabulate renders the component with its git source, then the workload containers and the pods tables.
+func (description *ComponentDescription) Tabulate(w *tabwriter.Writer) {
+	formatter.WriteStylish(w, description.Component)
+
+	if git := description.Git; git != nil {
+		fmt.Fprintf(w, "%v\t %v\n", "Repository", git.GetRepository())
+		fmt.Fprintf(w, "%v\t %v\n", "Branch", git.GetRefName())
+		fmt.Fprintf(w, "%v\t %v\n", "Path", git.GetPath())
+		fmt.Fprintf(w, "%v\t %v\n", "DeployedSha", git.GetDeployedSha())
+	}
+
+	// flush each table so it aligns on its own
+	w.Flush()
+
+	fmt.Fprintf(w, "\n%v\t %v\t %v\t %v\t %v\t %v\n", "Resource", "Replicas", "Container", "Image", "Requests", "Limits")
+
+	for _, workload := range description.Workloads {
+		for _, container := range workload.Containers {
+			fmt.Fprintf(
+				w,
+				"%v\t %v\t %v\t %v\t %v\t %v\n",
+				workload.Resource,
+				fmt.Sprintf("%d/%d", workload.ReadyReplicas, workload.Replicas),
+				container.Name,
+				container.Image,
+				joinResources(container.Requests),
+				joinResources(container.Limits),
+			)
+		}
+	}
+
+	w.Flush()
+
+	fmt.Fprintln(w)
+	description.Pods.Tabulate(w)
+}
+
+// DescribeComponent fetches the component with its git source, and its workloads and pods from the cluster.
+func DescribeComponent(componentID string) (*ComponentDescription, error) {
+	cluster, err := loadComponentCluster(componentID)
+	if err != nil {
+		return nil, err
+	}
+
+	git, err := componentGit.Get(componentGit.NewItemOptions(componentID))
+	if err != nil && !api.IsNotFound(err) {
+		return nil, err
+	}
+
+	workloads := []WorkloadDescription{}
+
+	for _, resource := range cluster.workloads {
+		workload, err := cluster.client.GetWorkload(resource.GetNamespace(), resource.GetKind(), resource.GetName())
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", getResourcePath(resource), err)
+		}
+
+		workloads = append(workloads, newWorkloadDescription(getResourcePath(resource), workload))
+	}
+
+	pods, err := getPodStatuses(cluster.client, cluster.workloads)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ComponentDescription{
+		Component: cluster.item,
+		Git:       git,
+
+		Workloads: workloads,
+		Pods:      pods,
+	}, nil
+}
+
+func newWorkloadDescription(resource string, workload *k8s.Workload) WorkloadDescription {
+	containers := []ContainerDescription{}
+
+	for _, container := range workload.Template.Spec.Containers {
+		containers = append(containers, ContainerDescription{
+			Name:  container.Name,
+			Image: container.Image,
+
+			Requests: resourceListToMap(container.Resources.Requests),
+			Limits:   resourceListToMap(container.Resources.Limits),
+		})
+	}
+
+	return WorkloadDescription{
+		Resource: resource,
+
+		Replicas:      workload.Replicas,
+		ReadyReplicas: workload.ReadyReplicas,
+
+		Containers: containers,
+	}
+}
+
+func resourceListToMap(resources coreV1.ResourceList) map[string]string {
+	if len(resources) == 0 {
+		return nil
+	}
+
+	result := map[string]string{}
+	for name, quantity := range resources {
+		result[string(name)] = quantity.String()
+	}
+
+	return result
+}
+
+func joinResources(resources map[string]string) string {
+	if len(resources) == 0 {
+		return "-"
+	}
+
+	names := make([]string, 0, len(resources))
+	for name := range resources {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	pairs := make([]string, len(names))
+	for index, name := range names {
+		pairs[index] = name + "=" + resources[name]
+	}
+
+	return strings.Join(pairs, ", ")
+}
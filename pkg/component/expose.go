@@ -0,0 +1,153 @@
+package component
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"bunnyshell.com/cli/pkg/util"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	exposeDefinitionIndent = 2
+
+	defaultExposeHostname = "%s-{{ env.base_domain }}"
+	defaultExposePath     = "/"
+)
+
+var (
+	ErrComponentNotInDefinition = errors.New("component not found in the environment definition")
+	ErrPortNotExposed           = errors.New("port is not exposed")
+
+	errInvalidDefinition = errors.New("definition components must be a list of mappings")
+)
+
+type ExposeOptions struct {
+	Port int
+
+	// Hostname defaults to one derived from the component name within the environment domain
+	Hostname string
+	Path     string
+
+	Remove bool
+}
+
+type exposedHost struct {
+	Hostname    string `yaml:"hostname"`
+	Path        string `yaml:"path"`
+	ServicePort int    `yaml:"servicePort"`
+}
+
+// ExposeInDefinition adds, replaces or removes the component host serving the port, keeping the rest of the definition as it is.
+func ExposeInDefinition(content []byte, componentName string, options ExposeOptions) ([]byte, error) {
+	document := yaml.Node{}
+	if err := yaml.Unmarshal(content, &document); err != nil {
+		return nil, err
+	}
+
+	if len(document.Content) == 0 || document.Content[0].Kind != yaml.MappingNode {
+		return nil, errInvalidDefinition
+	}
+
+	componentNode, err := findDefinitionComponent(document.Content[0], componentName)
+	if err != nil {
+		return nil, err
+	}
+
+	hosts := util.FindKey(componentNode, "hosts")
+	if hosts == nil {
+		hosts = &yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq"}
+
+		componentNode.Content = append(componentNode.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: "hosts"}, hosts)
+	} else if hosts.Kind != yaml.SequenceNode {
+		// an empty "hosts:" is parsed as null
+		hosts.Kind, hosts.Tag, hosts.Value = yaml.SequenceNode, "!!seq", ""
+	}
+
+	kept := []*yaml.Node{}
+
+	for _, host := range hosts.Content {
+		if !isHostForPort(host, options.Port) {
+			kept = append(kept, host)
+		}
+	}
+
+	if options.Remove {
+		if len(kept) == len(hosts.Content) {
+			return nil, fmt.Errorf("%w: %d on component %s", ErrPortNotExposed, options.Port, componentName)
+		}
+
+		hosts.Content = kept
+	} else {
+		host := &yaml.Node{}
+		if err = host.Encode(newExposedHost(componentName, options)); err != nil {
+			return nil, err
+		}
+
+		hosts.Content = append(kept, host)
+	}
+
+	var buffer bytes.Buffer
+
+	encoder := yaml.NewEncoder(&buffer)
+	encoder.SetIndent(exposeDefinitionIndent)
+
+	if err = encoder.Encode(&document); err != nil {
+		return nil, err
+	}
+
+	if err = encoder.Close(); err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
+
+func newExposedHost(componentName string, options ExposeOptions) exposedHost {
+	host := exposedHost{
+		Hostname:    options.Hostname,
+		Path:        options.Path,
+		ServicePort: options.Port,
+	}
+
+	if host.Hostname == "" {
+		host.Hostname = fmt.Sprintf(defaultExposeHostname, componentName)
+	}
+
+	if host.Path == "" {
+		host.Path = defaultExposePath
+	}
+
+	return host
+}
+
+func findDefinitionComponent(root *yaml.Node, componentName string) (*yaml.Node, error) {
+	components := util.FindKey(root, "components")
+	if components == nil || components.Kind != yaml.SequenceNode {
+		return nil, errInvalidDefinition
+	}
+
+	for _, item := range components.Content {
+		if item.Kind != yaml.MappingNode {
+			return nil, errInvalidDefinition
+		}
+
+		if name := util.FindKey(item, "name"); name != nil && name.Value == componentName {
+			return item, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: %s", ErrComponentNotInDefinition, componentName)
+}
+
+func isHostForPort(host *yaml.Node, port int) bool {
+	if host.Kind != yaml.MappingNode {
+		return false
+	}
+
+	servicePort := util.FindKey(host, "servicePort")
+
+	return servicePort != nil && servicePort.Value == strconv.Itoa(port)
+}
@@ -0,0 +1,83 @@
+package component
+
+import (
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	coreV1 "k8s.io/api/core/v1"
+)
+
+type ContainerImage struct {
+	Pod       string `json:"pod" yaml:"pod"`
+	Container string `json:"container" yaml:"container"`
+
+	Image  string `json:"image" yaml:"image"`
+	Digest string `json:"digest" yaml:"digest"`
+}
+
+type ComponentImages []ContainerImage
+
+func (images ComponentImages) Tabulate(w *tabwriter.Writer) {
+	fmt.Fprintf(w, "%v\t %v\t %v\t %v\n", "Pod", "Container", "Image", "Digest")
+
+	for _, image := range images {
+		fmt.Fprintf(w, "%v\t %v\t %v\t %v\n", image.Pod, image.Container, image.Image, image.Digest)
+	}
+}
+
+// GetComponentImages returns the images the component containers run, with the digest the cluster pulled.
+func GetComponentImages(componentID string) (ComponentImages, error) {
+	cluster, err := loadComponentCluster(componentID)
+	if err != nil {
+		return nil, err
+	}
+
+	images := ComponentImages{}
+
+	for _, workload := range cluster.workloads {
+		pods, err := cluster.client.ResourcePods(workload.GetNamespace(), workload.GetKind(), workload.GetName())
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", getResourcePath(workload), err)
+		}
+
+		for _, pod := range pods {
+			if pod.DeletionTimestamp != nil {
+				continue
+			}
+
+			images = append(images, getContainerImages(pod)...)
+		}
+	}
+
+	return images, nil
+}
+
+func getContainerImages(pod coreV1.Pod) []ContainerImage {
+	images := []ContainerImage{}
+
+	for _, status := range pod.Status.ContainerStatuses {
+		images = append(images, ContainerImage{
+			Pod:       pod.Name,
+			Container: status.Name,
+
+			Image:  status.Image,
+			Digest: getImageDigest(status.ImageID),
+		})
+	}
+
+	return images
+}
+
+// the image ID is either "[scheme://]repository@sha256:..." or the bare "sha256:..." of the container runtime
+func getImageDigest(imageID string) string {
+	if _, digest, found := strings.Cut(imageID, "@"); found {
+		return digest
+	}
+
+	if index := strings.Index(imageID, "sha256:"); index >= 0 {
+		return imageID[index:]
+	}
+
+	return imageID
+}
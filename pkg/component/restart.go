@@ -0,0 +1,230 @@
+package component
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"bunnyshell.com/cli/pkg/api/component"
+	"bunnyshell.com/cli/pkg/api/environment"
+	"bunnyshell.com/cli/pkg/k8s"
+	bunnysdk "bunnyshell.com/sdk"
+	coreV1 "k8s.io/api/core/v1"
+)
+
+var (
+	ErrRolloutTimeout = errors.New("component did not become ready in time")
+
+	errNoRestartableWorkload = errors.New("the component has no deployment, statefulset or daemonset to restart")
+)
+
+const crashLoopBackOffReason = "CrashLoopBackOff"
+
+var workloadResourceKinds = map[string]bool{
+	k8s.DeploymentKind:  true,
+	k8s.StatefulSetKind: true,
+	k8s.DaemonSetKind:   true,
+}
+
+type RolloutOptions struct {
+	Wait bool
+
+	Interval time.Duration
+	Timeout  time.Duration
+}
+
+type PodStatus struct {
+	Resource string `json:"resource" yaml:"resource"`
+	Pod      string `json:"pod" yaml:"pod"`
+
+	Phase    string `json:"phase" yaml:"phase"`
+	Ready    string `json:"ready" yaml:"ready"`
+	Restarts int32  `json:"restarts" yaml:"restarts"`
+
+	ready        bool
+	crashLooping bool
+}
+
+type PodStatuses []PodStatus
+
+func (statuses PodStatuses) Tabulate(w *tabwriter.Writer) {
+	fmt.Fprintf(w, "%v\t %v\t %v\t %v\t %v\n", "Resource", "Pod", "Phase", "Ready", "Restarts")
+
+	for _, status := range statuses {
+		fmt.Fprintf(w, "%v\t %v\t %v\t %v\t %v\n", status.Resource, status.Pod, status.Phase, status.Ready, status.Restarts)
+	}
+}
+
+// RestartComponent rolls out new pods for the component workloads, returning the pods once they are ready when waiting.
+func RestartComponent(componentID string, options RolloutOptions) (PodStatuses, error) {
+	cluster, err := loadComponentCluster(componentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(cluster.workloads) == 0 {
+		return nil, errNoRestartableWorkload
+	}
+
+	for _, workload := range cluster.workloads {
+		if err = cluster.client.RolloutRestart(workload.GetNamespace(), workload.GetKind(), workload.GetName()); err != nil {
+			return nil, fmt.Errorf("%s: %w", getResourcePath(workload), err)
+		}
+	}
+
+	return cluster.finishRollout(options)
+}
+
+// componentCluster is the component along with a client to the cluster running its workloads.
+type componentCluster struct {
+	item *bunnysdk.ComponentItem
+
+	client    *k8s.KubernetesClient
+	workloads []bunnysdk.ComponentResourceItem
+}
+
+func loadComponentCluster(componentID string) (*componentCluster, error) {
+	item, err := component.Get(component.NewItemOptions(componentID))
+	if err != nil {
+		return nil, err
+	}
+
+	kubeConfig, err := environment.KubeConfig(environment.NewKubeConfigOptions(item.GetEnvironment()))
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := k8s.NewKubernetesClientFromBytes(kubeConfig.Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	workloads, err := getWorkloadResources(item.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &componentCluster{
+		item: item,
+
+		client:    client,
+		workloads: workloads,
+	}, nil
+}
+
+// finishRollout waits for the workloads when asked to, returning their pods.
+func (cluster *componentCluster) finishRollout(options RolloutOptions) (PodStatuses, error) {
+	if options.Wait {
+		if err := waitForRollouts(cluster.client, cluster.workloads, options); err != nil {
+			return nil, err
+		}
+	}
+
+	return getPodStatuses(cluster.client, cluster.workloads)
+}
+
+// getWorkloadResources lists the component deployments, statefulsets and daemonsets.
+func getWorkloadResources(componentID string) ([]bunnysdk.ComponentResourceItem, error) {
+	resources, err := component.Resources(component.NewResourceOptions(componentID))
+	if err != nil {
+		return nil, err
+	}
+
+	workloads := []bunnysdk.ComponentResourceItem{}
+
+	for _, resource := range resources {
+		if workloadResourceKinds[strings.ToLower(resource.GetKind())] {
+			workloads = append(workloads, resource)
+		}
+	}
+
+	return workloads, nil
+}
+
+func waitForRollouts(client *k8s.KubernetesClient, workloads []bunnysdk.ComponentResourceItem, options RolloutOptions) error {
+	deadline := time.Now().Add(options.Timeout)
+
+	for _, workload := range workloads {
+		for {
+			complete, err := client.IsRolloutComplete(workload.GetNamespace(), workload.GetKind(), workload.GetName())
+			if err != nil {
+				return fmt.Errorf("%s: %w", getResourcePath(workload), err)
+			}
+
+			if complete {
+				break
+			}
+
+			if options.Timeout > 0 && time.Now().After(deadline) {
+				return fmt.Errorf("%w: %s", ErrRolloutTimeout, getResourcePath(workload))
+			}
+
+			time.Sleep(options.Interval)
+		}
+	}
+
+	return nil
+}
+
+func getPodStatuses(client *k8s.KubernetesClient, workloads []bunnysdk.ComponentResourceItem) (PodStatuses, error) {
+	statuses := PodStatuses{}
+
+	for _, workload := range workloads {
+		pods, err := client.ResourcePods(workload.GetNamespace(), workload.GetKind(), workload.GetName())
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", getResourcePath(workload), err)
+		}
+
+		for _, pod := range pods {
+			// the replaced pods may still be terminating
+			if pod.DeletionTimestamp != nil {
+				continue
+			}
+
+			statuses = append(statuses, newPodStatus(getResourcePath(workload), pod))
+		}
+	}
+
+	return statuses, nil
+}
+
+func newPodStatus(resource string, pod coreV1.Pod) PodStatus {
+	ready := 0
+	restarts := int32(0)
+	crashLooping := false
+
+	for _, containerStatus := range pod.Status.ContainerStatuses {
+		if containerStatus.Ready {
+			ready++
+		}
+
+		restarts += containerStatus.RestartCount
+		crashLooping = crashLooping || isCrashLooping(containerStatus)
+	}
+
+	for _, containerStatus := range pod.Status.InitContainerStatuses {
+		crashLooping = crashLooping || isCrashLooping(containerStatus)
+	}
+
+	return PodStatus{
+		Resource: resource,
+		Pod:      pod.Name,
+
+		Phase:    string(pod.Status.Phase),
+		Ready:    fmt.Sprintf("%d/%d", ready, len(pod.Spec.Containers)),
+		Restarts: restarts,
+
+		ready:        ready == len(pod.Spec.Containers),
+		crashLooping: crashLooping,
+	}
+}
+
+func isCrashLooping(containerStatus coreV1.ContainerStatus) bool {
+	return containerStatus.State.Waiting != nil && containerStatus.State.Waiting.Reason == crashLoopBackOffReason
+}
+
+func getResourcePath(resource bunnysdk.ComponentResourceItem) string {
+	return resource.GetNamespace() + "/" + strings.ToLower(resource.GetKind()) + "/" + resource.GetName()
+}
@@ -0,0 +1,114 @@
+package component
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"bunnyshell.com/cli/pkg/k8s"
+	bunnysdk "bunnyshell.com/sdk"
+)
+
+var (
+	errNoScalableWorkload = errors.New("the component has no deployment or statefulset to scale")
+	errComponentNotPaused = errors.New("the component is not paused")
+)
+
+// ScaleComponent sets the replicas of the component deployments and statefulsets.
+func ScaleComponent(componentID string, replicas int32, options RolloutOptions) (PodStatuses, error) {
+	cluster, err := loadComponentCluster(componentID)
+	if err != nil {
+		return nil, err
+	}
+
+	scalable, err := cluster.getScalableWorkloads()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, workload := range scalable {
+		if err = cluster.client.Scale(workload.GetNamespace(), workload.GetKind(), workload.GetName(), replicas); err != nil {
+			return nil, fmt.Errorf("%s: %w", getResourcePath(workload), err)
+		}
+	}
+
+	cluster.workloads = scalable
+
+	return cluster.finishRollout(options)
+}
+
+// PauseComponent scales the component deployments and statefulsets to zero, until resumed.
+func PauseComponent(componentID string, options RolloutOptions) (PodStatuses, error) {
+	cluster, err := loadComponentCluster(componentID)
+	if err != nil {
+		return nil, err
+	}
+
+	scalable, err := cluster.getScalableWorkloads()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, workload := range scalable {
+		if err = cluster.client.Pause(workload.GetNamespace(), workload.GetKind(), workload.GetName()); err != nil {
+			return nil, fmt.Errorf("%s: %w", getResourcePath(workload), err)
+		}
+	}
+
+	cluster.workloads = scalable
+
+	return cluster.finishRollout(options)
+}
+
+// ResumeComponent brings the paused component workloads back to the replicas they had.
+func ResumeComponent(componentID string, options RolloutOptions) (PodStatuses, error) {
+	cluster, err := loadComponentCluster(componentID)
+	if err != nil {
+		return nil, err
+	}
+
+	scalable, err := cluster.getScalableWorkloads()
+	if err != nil {
+		return nil, err
+	}
+
+	resumed := []bunnysdk.ComponentResourceItem{}
+
+	for _, workload := range scalable {
+		wasPaused, err := cluster.client.Resume(workload.GetNamespace(), workload.GetKind(), workload.GetName())
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", getResourcePath(workload), err)
+		}
+
+		if wasPaused {
+			resumed = append(resumed, workload)
+		}
+	}
+
+	if len(resumed) == 0 {
+		return nil, errComponentNotPaused
+	}
+
+	cluster.workloads = resumed
+
+	return cluster.finishRollout(options)
+}
+
+// daemonsets run one pod per node, they cannot be scaled
+func (cluster *componentCluster) getScalableWorkloads() ([]bunnysdk.ComponentResourceItem, error) {
+	scalable := []bunnysdk.ComponentResourceItem{}
+
+	for _, workload := range cluster.workloads {
+		if strings.ToLower(workload.GetKind()) == k8s.DaemonSetKind {
+			continue
+		}
+
+		scalable = append(scalable, workload)
+	}
+
+	if len(scalable) == 0 {
+		return nil, errNoScalableWorkload
+	}
+
+	return scalable, nil
+}
@@ -0,0 +1,119 @@
+package component
+
+import (
+	"fmt"
+	"text/tabwriter"
+
+	coreV1 "k8s.io/api/core/v1"
+)
+
+const bytesInMebibyte = 1024 * 1024
+
+type ContainerUsage struct {
+	Pod       string `json:"pod" yaml:"pod"`
+	Container string `json:"container" yaml:"container"`
+
+	CPU         string `json:"cpu" yaml:"cpu"`
+	CPULimit    string `json:"cpuLimit,omitempty" yaml:"cpuLimit,omitempty"`
+	Memory      string `json:"memory" yaml:"memory"`
+	MemoryLimit string `json:"memoryLimit,omitempty" yaml:"memoryLimit,omitempty"`
+}
+
+type ComponentUsage []ContainerUsage
+
+func (usage ComponentUsage) Tabulate(w *tabwriter.Writer) {
+	fmt.Fprintf(w, "%v\t %v\t %v\t %v\n", "Pod", "Container", "CPU", "Memory")
+
+	for _, item := range usage {
+		fmt.Fprintf(
+			w,
+			"%v\t %v\t %v\t %v\n",
+			item.Pod,
+			item.Container,
+			withLimit(item.CPU, item.CPULimit),
+			withLimit(item.Memory, item.MemoryLimit),
+		)
+	}
+}
+
+// TopComponent reports the live CPU and memory usage of the running component pods.
+func TopComponent(componentID string) (ComponentUsage, error) {
+	cluster, err := loadComponentCluster(componentID)
+	if err != nil {
+		return nil, err
+	}
+
+	usage := ComponentUsage{}
+
+	for _, workload := range cluster.workloads {
+		pods, err := cluster.client.ResourcePods(workload.GetNamespace(), workload.GetKind(), workload.GetName())
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", getResourcePath(workload), err)
+		}
+
+		for _, pod := range pods {
+			if pod.Status.Phase != coreV1.PodRunning || pod.DeletionTimestamp != nil {
+				continue
+			}
+
+			containersUsage, err := cluster.client.GetPodUsage(pod.Namespace, pod.Name)
+			if err != nil {
+				return nil, err
+			}
+
+			usage = append(usage, getContainersUsage(pod, containersUsage)...)
+		}
+	}
+
+	return usage, nil
+}
+
+func getContainersUsage(pod coreV1.Pod, containersUsage map[string]coreV1.ResourceList) []ContainerUsage {
+	result := []ContainerUsage{}
+
+	for _, container := range pod.Spec.Containers {
+		usage, found := containersUsage[container.Name]
+		if !found {
+			continue
+		}
+
+		result = append(result, ContainerUsage{
+			Pod:       pod.Name,
+			Container: container.Name,
+
+			CPU:         formatCPU(usage),
+			CPULimit:    formatCPU(container.Resources.Limits),
+			Memory:      formatMemory(usage),
+			MemoryLimit: formatMemory(container.Resources.Limits),
+		})
+	}
+
+	return result
+}
+
+// formatCPU uses millicores, the metrics server reports nanocores
+func formatCPU(resources coreV1.ResourceList) string {
+	quantity, found := resources[coreV1.ResourceCPU]
+	if !found {
+		return ""
+	}
+
+	return fmt.Sprintf("%dm", quantity.MilliValue())
+}
+
+func formatMemory(resources coreV1.ResourceList) string {
+	quantity, found := resources[coreV1.ResourceMemory]
+	if !found {
+		return ""
+	}
+
+	return fmt.Sprintf("%dMi", quantity.Value()/bytesInMebibyte)
+}
+
+func withLimit(usage string, limit string) string {
+	if limit == "" {
+		return usage
+	}
+
+	return usage + " / " + limit
+}
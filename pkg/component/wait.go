@@ -0,0 +1,132 @@
+package component
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+type ComponentWaitCondition string
+
+const ComponentWaitReady ComponentWaitCondition = "ready"
+
+var ComponentWaitConditions = []ComponentWaitCondition{ComponentWaitReady}
+
+var (
+	ErrCrashLoop            = errors.New("component pods are crash looping")
+	ErrUnknownWaitCondition = errors.New("unknown wait condition")
+
+	errNoWaitableWorkload = errors.New("the component has no deployment, statefulset or daemonset to wait for")
+)
+
+type ComponentWaitOptions struct {
+	Condition ComponentWaitCondition
+
+	Interval time.Duration
+	Timeout  time.Duration
+
+	// OnChange is called with the component pods each time they change
+	OnChange func(statuses PodStatuses)
+}
+
+func ParseComponentWaitCondition(value string) (ComponentWaitCondition, error) {
+	for _, condition := range ComponentWaitConditions {
+		if string(condition) == value {
+			return condition, nil
+		}
+	}
+
+	return "", fmt.Errorf("%w %q, expected one of %s", ErrUnknownWaitCondition, value, JoinComponentWaitConditions())
+}
+
+func JoinComponentWaitConditions() string {
+	names := make([]string, 0, len(ComponentWaitConditions))
+	for _, condition := range ComponentWaitConditions {
+		names = append(names, string(condition))
+	}
+
+	return strings.Join(names, ", ")
+}
+
+// WaitComponent polls the component pods until they are all ready, one of them is crash looping or the timeout is reached.
+func WaitComponent(componentID string, options ComponentWaitOptions) (PodStatuses, error) {
+	cluster, err := loadComponentCluster(componentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(cluster.workloads) == 0 {
+		return nil, errNoWaitableWorkload
+	}
+
+	var (
+		previous PodStatuses
+		deadline time.Time
+	)
+
+	if options.Timeout > 0 {
+		deadline = time.Now().Add(options.Timeout)
+	}
+
+	for first := true; ; first = false {
+		statuses, err := getPodStatuses(cluster.client, cluster.workloads)
+		if err != nil {
+			return nil, err
+		}
+
+		if options.OnChange != nil && (first || !slices.Equal(statuses, previous)) {
+			options.OnChange(statuses)
+		}
+
+		for _, status := range statuses {
+			if status.crashLooping {
+				return statuses, fmt.Errorf("%w: %s", ErrCrashLoop, status.Pod)
+			}
+		}
+
+		ready, err := cluster.isReady(statuses)
+		if err != nil {
+			return nil, err
+		}
+
+		if ready {
+			return statuses, nil
+		}
+
+		if !deadline.IsZero() && time.Now().After(deadline) {
+			return statuses, ErrRolloutTimeout
+		}
+
+		previous = statuses
+
+		time.Sleep(options.Interval)
+	}
+}
+
+// isReady requires the rollouts to be complete as well, so pods of a previous revision do not count.
+func (cluster *componentCluster) isReady(statuses PodStatuses) (bool, error) {
+	if len(statuses) == 0 {
+		return false, nil
+	}
+
+	for _, status := range statuses {
+		if !status.ready {
+			return false, nil
+		}
+	}
+
+	for _, workload := range cluster.workloads {
+		complete, err := cluster.client.IsRolloutComplete(workload.GetNamespace(), workload.GetKind(), workload.GetName())
+		if err != nil {
+			return false, fmt.Errorf("%s: %w", getResourcePath(workload), err)
+		}
+
+		if !complete {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
@@ -0,0 +1,247 @@
+package compose
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	defaultEnvironmentName = "compose"
+
+	componentKind = "Service"
+	hostTemplate  = "%s-{{ env.base_domain }}"
+)
+
+var (
+	errNoServices = errors.New("compose file does not define any services")
+
+	// service fields copied as they are into the component dockerCompose section
+	supportedServiceFields = map[string]bool{
+		"image":       true,
+		"command":     true,
+		"entrypoint":  true,
+		"environment": true,
+		"ports":       true,
+		"working_dir": true,
+	}
+)
+
+// Result holds the generated bunnyshell.yaml and the compose constructs left out of it.
+type Result struct {
+	Manifest []byte
+
+	Skipped []string
+}
+
+type manifest struct {
+	Kind       string      `yaml:"kind"`
+	Name       string      `yaml:"name"`
+	Type       string      `yaml:"type"`
+	Components []component `yaml:"components"`
+}
+
+type component struct {
+	Kind          string                 `yaml:"kind"`
+	Name          string                 `yaml:"name"`
+	DependsOn     []string               `yaml:"dependsOn,omitempty"`
+	DockerCompose map[string]interface{} `yaml:"dockerCompose"`
+	Hosts         []host                 `yaml:"hosts,omitempty"`
+}
+
+type host struct {
+	Hostname    string `yaml:"hostname"`
+	Path        string `yaml:"path"`
+	ServicePort int    `yaml:"servicePort"`
+}
+
+type composeFile struct {
+	Name     string                            `yaml:"name"`
+	Services map[string]map[string]interface{} `yaml:"services"`
+
+	extra map[string]interface{}
+}
+
+// Convert turns the services of a Docker Compose file into environment components.
+func Convert(content []byte, environmentName string) (*Result, error) {
+	file, err := parse(content)
+	if err != nil {
+		return nil, err
+	}
+
+	if environmentName == "" {
+		environmentName = file.Name
+	}
+
+	if environmentName == "" {
+		environmentName = defaultEnvironmentName
+	}
+
+	result := &Result{
+		Skipped: []string{},
+	}
+
+	for key := range file.extra {
+		result.Skipped = append(result.Skipped, key)
+	}
+
+	environment := manifest{
+		Kind:       "Environment",
+		Name:       environmentName,
+		Type:       "primary",
+		Components: []component{},
+	}
+
+	for _, name := range sortedKeys(file.Services) {
+		serviceComponent, skipped := convertService(name, file.Services[name])
+
+		result.Skipped = append(result.Skipped, skipped...)
+
+		if serviceComponent != nil {
+			environment.Components = append(environment.Components, *serviceComponent)
+		}
+	}
+
+	sort.Strings(result.Skipped)
+
+	if result.Manifest, err = yaml.Marshal(environment); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func parse(content []byte) (*composeFile, error) {
+	document := map[string]interface{}{}
+	if err := yaml.Unmarshal(content, &document); err != nil {
+		return nil, err
+	}
+
+	file := &composeFile{}
+	if err := yaml.Unmarshal(content, file); err != nil {
+		return nil, err
+	}
+
+	if len(file.Services) == 0 {
+		return nil, errNoServices
+	}
+
+	delete(document, "services")
+	delete(document, "name")
+	// the compose "version" field is obsolete and carries no information
+	delete(document, "version")
+
+	file.extra = document
+
+	return file, nil
+}
+
+func convertService(name string, service map[string]interface{}) (*component, []string) {
+	skipped := []string{}
+
+	if _, ok := service["image"]; !ok {
+		return nil, []string{fmt.Sprintf("services.%s (no image, building from source is not supported)", name)}
+	}
+
+	serviceComponent := &component{
+		Kind:          componentKind,
+		Name:          name,
+		DockerCompose: map[string]interface{}{},
+	}
+
+	for field, value := range service {
+		switch {
+		case field == "depends_on":
+			serviceComponent.DependsOn = getDependencies(value)
+		case supportedServiceFields[field]:
+			serviceComponent.DockerCompose[field] = value
+		default:
+			skipped = append(skipped, fmt.Sprintf("services.%s.%s", name, field))
+		}
+	}
+
+	if port, ok := getPublishedPort(service["ports"]); ok {
+		serviceComponent.Hosts = []host{
+			{
+				Hostname:    fmt.Sprintf(hostTemplate, name),
+				Path:        "/",
+				ServicePort: port,
+			},
+		}
+	}
+
+	return serviceComponent, skipped
+}
+
+// getDependencies supports both the list and the map depends_on syntax.
+func getDependencies(value interface{}) []string {
+	dependencies := []string{}
+
+	switch typed := value.(type) {
+	case []interface{}:
+		for _, dependency := range typed {
+			dependencies = append(dependencies, fmt.Sprint(dependency))
+		}
+	case map[string]interface{}:
+		dependencies = append(dependencies, sortedKeys(typed)...)
+	}
+
+	sort.Strings(dependencies)
+
+	return dependencies
+}
+
+// getPublishedPort finds the first published port, supporting both the short ([ip:]host:container[/protocol]) and the long syntax.
+func getPublishedPort(value interface{}) (int, bool) {
+	ports, ok := value.([]interface{})
+	if !ok {
+		return 0, false
+	}
+
+	for _, port := range ports {
+		if target, ok := getPublishedTarget(port); ok {
+			return target, true
+		}
+	}
+
+	return 0, false
+}
+
+func getPublishedTarget(port interface{}) (int, bool) {
+	if long, ok := port.(map[string]interface{}); ok {
+		if _, published := long["published"]; !published {
+			return 0, false
+		}
+
+		target, err := strconv.Atoi(fmt.Sprint(long["target"]))
+
+		return target, err == nil
+	}
+
+	spec := strings.SplitN(fmt.Sprint(port), "/", 2)[0]
+
+	separator := strings.LastIndex(spec, ":")
+	if separator == -1 {
+		return 0, false
+	}
+
+	// port ranges cannot be exposed through a single host
+	target, err := strconv.Atoi(spec[separator+1:])
+
+	return target, err == nil
+}
+
+func sortedKeys[T any](data map[string]T) []string {
+	keys := make([]string, 0, len(data))
+	for key := range data {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
@@ -7,6 +7,8 @@ import (
 type Config struct {
 	Debug bool `json:"debug" yaml:"debug"`
 
+	NoAutoSelect bool `json:"noAutoSelect,omitempty" yaml:"noAutoSelect,omitempty"`
+
 	OutputFormat string        `json:"outputFormat,omitempty" yaml:"outputFormat,omitempty"`
 	Timeout      time.Duration `json:"timeout,omitempty" yaml:"timeout,omitempty"`
 
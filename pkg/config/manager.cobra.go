@@ -17,6 +17,7 @@ func (manager *Manager) CommandWithGlobalOptions(command *cobra.Command) {
 	flags.AddFlag(manager.options.Debug.GetMainFlag())
 	flags.AddFlag(manager.options.NoProgress.GetMainFlag())
 	flags.AddFlag(manager.options.NonInteractive.GetMainFlag())
+	flags.AddFlag(manager.options.NoAutoSelect.GetMainFlag())
 	flags.AddFlag(manager.options.Verbosity.GetMainFlag())
 
 	profileFlag := manager.options.ProfileName.GetMainFlag()
@@ -26,6 +27,11 @@ func (manager *Manager) CommandWithGlobalOptions(command *cobra.Command) {
 	outputFormatFlag := manager.options.OutputFormat.GetMainFlag()
 	flags.AddFlag(outputFormatFlag)
 	_ = command.RegisterFlagCompletionFunc(outputFormatFlag.Name, manager.outputTypesCompletion())
+
+	flags.AddFlag(manager.options.GroupBy.GetMainFlag())
+	flags.AddFlag(manager.options.OutputFile.GetMainFlag())
+	flags.AddFlag(manager.options.OutputThrough.GetMainFlag())
+	flags.AddFlag(manager.options.MaskPattern.GetMainFlag())
 }
 
 func (manager *Manager) CommandWithAPI(command *cobra.Command) {
@@ -34,6 +40,12 @@ func (manager *Manager) CommandWithAPI(command *cobra.Command) {
 	flags.AddFlag(manager.options.Token.GetFlag("token", util.FlagRequired, util.FlagHidden))
 	flags.AddFlag(manager.options.Host.GetMainFlag())
 	flags.AddFlag(manager.options.Timeout.GetMainFlag())
+	flags.AddFlag(manager.options.PrintRequest.GetMainFlag())
+
+	flags.AddFlag(manager.options.OrganizationName.GetMainFlag())
+	flags.AddFlag(manager.options.ProjectName.GetMainFlag())
+	flags.AddFlag(manager.options.EnvironmentName.GetMainFlag())
+	flags.AddFlag(manager.options.ServiceComponentName.GetMainFlag())
 }
 
 func (manager *Manager) profileNamesCompletion() ShellCompletion {
@@ -61,6 +61,13 @@ func (manager *Manager) importConfig(config *Config) {
 
 		return config.Debug
 	})
+	manager.options.NoAutoSelect.ValueOr(func(flag *pflag.Flag) bool {
+		if manager.viper.IsSet(flag.Name) {
+			return manager.viper.GetBool(flag.Name)
+		}
+
+		return config.NoAutoSelect
+	})
 
 	if manager.settings.Profile.Name == "" {
 		manager.importProfile(&Profile{})
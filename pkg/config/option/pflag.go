@@ -53,6 +53,16 @@ func newDurationValue(val *time.Duration) Value {
 	return valueFrom(name)
 }
 
+// unexported newStringArrayValue()
+// @see https://github.com/spf13/pflag/blob/v1.0.5/string_array.go
+func newStringArrayValue(val *[]string) Value {
+	name := getNewFlagName()
+
+	flagSet.StringArrayVar(val, name, *val, "")
+
+	return valueFrom(name)
+}
+
 func getNewFlagName() string {
 	flagCount++
 
@@ -50,6 +50,11 @@ func (option *String) ValueOr(generator StringGenerator) string {
 	return value
 }
 
+// SetValue overrides the value of all the flags in the group, as if given on the command line.
+func (option *String) SetValue(value string) error {
+	return option.updateFlags(value)
+}
+
 func (option *String) AddFlag(name string, usage string, flagTypes ...util.BoolFlagType) *pflag.Flag {
 	flag := option.AddFlagShort(name, "", usage)
 
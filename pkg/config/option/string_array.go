@@ -0,0 +1,26 @@
+package option
+
+import (
+	"github.com/spf13/pflag"
+)
+
+type StringArray struct {
+	String
+}
+
+func NewStringArrayOption(val *[]string) *StringArray {
+	value := newStringArrayValue(val)
+
+	return &StringArray{
+		String: *NewStringValueOption(value),
+	}
+}
+
+func (option *StringArray) AddFlag(name string, usage string) *pflag.Flag {
+	flag := option.String.AddFlag(name, usage)
+
+	// hide the "[]" default from the help
+	flag.DefValue = ""
+
+	return flag
+}
@@ -2,6 +2,7 @@ package config
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 
 	"bunnyshell.com/cli/pkg/build"
@@ -18,11 +19,17 @@ type Options struct {
 	Timeout        *option.Duration
 	NoProgress     *option.Bool
 	NonInteractive *option.Bool
+	NoAutoSelect   *option.Bool
+	PrintRequest   *option.Bool
 
 	// global options
-	Debug        *option.Bool
-	OutputFormat *option.String
-	ProfileName  *option.String
+	Debug         *option.Bool
+	OutputFormat  *option.String
+	GroupBy       *option.String
+	OutputFile    *option.String
+	OutputThrough *option.String
+	MaskPattern   *option.StringArray
+	ProfileName   *option.String
 
 	// profile options
 	Host   *option.String
@@ -34,6 +41,12 @@ type Options struct {
 	Project          *option.String
 	Environment      *option.String
 	ServiceComponent *option.String
+
+	// Profile.Context options given by name
+	OrganizationName     *option.String
+	ProjectName          *option.String
+	EnvironmentName      *option.String
+	ServiceComponentName *option.String
 }
 
 func NewOptions(settings *Settings) *Options {
@@ -44,10 +57,16 @@ func NewOptions(settings *Settings) *Options {
 		Timeout:        newTimeout(settings),
 		NoProgress:     newNoProgress(settings),
 		NonInteractive: newNonInteractive(settings),
+		NoAutoSelect:   newNoAutoSelect(settings),
+		PrintRequest:   newPrintRequest(settings),
 
-		Debug:        newDebug(settings),
-		OutputFormat: newOutputFormat(settings),
-		ProfileName:  newProfileName(settings),
+		Debug:         newDebug(settings),
+		OutputFormat:  newOutputFormat(settings),
+		GroupBy:       newGroupBy(settings),
+		OutputFile:    newOutputFile(settings),
+		OutputThrough: newOutputThrough(settings),
+		MaskPattern:   newMaskPattern(settings),
+		ProfileName:   newProfileName(settings),
 
 		Host:   newHost(settings),
 		Scheme: newScheme(settings),
@@ -57,6 +76,11 @@ func NewOptions(settings *Settings) *Options {
 		Project:          newProject(settings),
 		Environment:      newEnvironment(settings),
 		ServiceComponent: newServiceComponent(settings),
+
+		OrganizationName:     newContextName(&settings.ContextNames.Organization, "organization-name", "OrganizationID"),
+		ProjectName:          newContextName(&settings.ContextNames.Project, "project-name", "ProjectID"),
+		EnvironmentName:      newContextName(&settings.ContextNames.Environment, "environment-name", "EnvironmentID"),
+		ServiceComponentName: newContextName(&settings.ContextNames.ServiceComponent, "service-component-name", "ServiceComponentID"),
 	}
 }
 
@@ -120,6 +144,46 @@ func newOutputFormat(settings *Settings) *option.String {
 	return option
 }
 
+func newGroupBy(settings *Settings) *option.String {
+	option := option.NewStringOption(&settings.GroupBy)
+
+	option.AddFlag("group-by", "Group collection tables by a field (stylish output only)")
+
+	return option
+}
+
+func newOutputFile(settings *Settings) *option.String {
+	option := option.NewStringOption(&settings.OutputFile)
+
+	option.AddFlag("output-file", "Write the command data to a file instead of the console, listings without interactive paging")
+
+	return option
+}
+
+func newOutputThrough(settings *Settings) *option.String {
+	option := option.NewStringOption(&settings.OutputThrough)
+
+	option.AddFlag("output-through", "Pipe the command data through a shell command (e.g. jq) and show its output instead")
+
+	return option
+}
+
+func newMaskPattern(settings *Settings) *option.StringArray {
+	option := option.NewStringArrayOption(&settings.MaskPatterns)
+
+	option.Var().Validator = func(data string, flag pflag.Value) error {
+		if _, err := regexp.Compile(data); err != nil {
+			return fmt.Errorf("%w: %s", ErrInvalidValue, err)
+		}
+
+		return nil
+	}
+
+	option.AddFlag("mask-pattern", "Replace the command output and data matching the regular expression with ****, line by line (repeatable). Progress, prompts and exec, cp or port-forward streams are not masked")
+
+	return option
+}
+
 func newNoProgress(settings *Settings) *option.Bool {
 	option := option.NewBoolOption(&settings.NoProgress)
 
@@ -136,6 +200,22 @@ func newNonInteractive(settings *Settings) *option.Bool {
 	return option
 }
 
+func newNoAutoSelect(settings *Settings) *option.Bool {
+	option := option.NewBoolOption(&settings.NoAutoSelect)
+
+	option.AddFlag("no-auto-select", "Do not auto-select the organization, project or environment when it is the only one available")
+
+	return option
+}
+
+func newPrintRequest(settings *Settings) *option.Bool {
+	option := option.NewBoolOption(&settings.PrintRequest)
+
+	option.AddFlag("print-request", "Print the URL and body of mutating requests to stderr before sending them")
+
+	return option
+}
+
 func newToken(settings *Settings) *option.String {
 	help := "Obtain your token from: https://environments.bunnyshell.com/access-token"
 
@@ -232,3 +312,11 @@ func newServiceComponent(settings *Settings) *option.String {
 
 	return option
 }
+
+func newContextName(value *string, name string, idName string) *option.String {
+	option := option.NewStringOption(value)
+
+	option.AddFlag(name, "Use the "+idName+" matching the name")
+
+	return option
+}
@@ -7,17 +7,26 @@ import (
 type Settings struct {
 	ConfigFile string
 
-	Debug      bool
-	NoProgress bool
+	Debug        bool
+	NoProgress   bool
+	PrintRequest bool
 
 	NonInteractive bool
+	NoAutoSelect   bool
 
 	Profile Profile
 
+	// ContextNames are resolved into the Profile.Context ids
+	ContextNames Context
+
 	Verbosity int
 
-	OutputFormat string
-	Timeout      time.Duration
+	OutputFormat  string
+	GroupBy       string
+	OutputFile    string
+	OutputThrough string
+	MaskPatterns  []string
+	Timeout       time.Duration
 }
 
 func NewSettings() *Settings {
@@ -30,3 +39,8 @@ func NewSettings() *Settings {
 func (settings *Settings) IsStylish() bool {
 	return settings.OutputFormat == "stylish"
 }
+
+// ShouldAutoSelect decides if a context resource can be picked without prompting.
+func (settings *Settings) ShouldAutoSelect(candidates int32) bool {
+	return !settings.NoAutoSelect && candidates == 1
+}
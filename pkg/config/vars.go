@@ -7,6 +7,7 @@ import (
 
 const (
 	defaultFormat  = "stylish"
+	OnelineFormat  = "oneline"
 	defaultTimeout = 30 * time.Second
 
 	configDirPerm  = 0o700
@@ -20,11 +21,15 @@ var (
 		"stylish",
 		"json",
 		"yaml",
+		"csv",
+		OnelineFormat,
 	}
 	FormatDescriptions = []string{
 		"stylish\tOutput format for human consumption",
 		"json\tOutput in JSON",
 		"yaml\tOutput in YAML",
+		"csv\tOutput in CSV (listings only)",
+		"oneline\tOne line per resource: ID NAME STATUS UPDATED (listings only)",
 	}
 
 	ErrConfigExists     = errors.New("configFile already exists")
@@ -0,0 +1,122 @@
+package environment
+
+import (
+	"fmt"
+	"sync"
+	"text/tabwriter"
+
+	"bunnyshell.com/cli/pkg/net"
+	"bunnyshell.com/cli/pkg/progress"
+	bunnysdk "bunnyshell.com/sdk"
+)
+
+const (
+	ActionScheduled = "scheduled"
+	ActionSucceeded = "succeeded"
+	ActionFailed    = "failed"
+)
+
+// EnvironmentAction schedules an action on an environment, returning the event following it.
+type EnvironmentAction func(environmentID string) (*bunnysdk.EventItem, error)
+
+type ActionResult struct {
+	Environment string `json:"environment" yaml:"environment"`
+
+	Event  string `json:"event,omitempty" yaml:"event,omitempty"`
+	Status string `json:"status" yaml:"status"`
+	Error  string `json:"error,omitempty" yaml:"error,omitempty"`
+}
+
+type ActionReport []ActionResult
+
+func (report ActionReport) HasFailures() bool {
+	for _, item := range report {
+		if item.Status == ActionFailed {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (report ActionReport) Tabulate(w *tabwriter.Writer) {
+	fmt.Fprintf(w, "%v\t %v\t %v\t %v\n", "EnvironmentID", "EventID", "Status", "Error")
+
+	for _, item := range report {
+		fmt.Fprintf(w, "%v\t %v\t %v\t %v\n", item.Environment, item.Event, item.Status, item.Error)
+	}
+}
+
+// RunAll runs the action on the environments, at most concurrency at a time, following the pipelines when wait is set.
+func RunAll(environmentIDs []string, concurrency int, wait bool, action EnvironmentAction) ActionReport {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	// the spinner is not safe for concurrent requests
+	resume := net.PauseSpinner()
+	defer resume()
+
+	report := make(ActionReport, len(environmentIDs))
+
+	semaphore := make(chan struct{}, concurrency)
+
+	var wg sync.WaitGroup
+
+	for index, environmentID := range environmentIDs {
+		wg.Add(1)
+
+		go func(index int, environmentID string) {
+			defer wg.Done()
+
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			report[index] = runAction(environmentID, wait, action)
+		}(index, environmentID)
+	}
+
+	wg.Wait()
+
+	return report
+}
+
+func runAction(environmentID string, wait bool, action EnvironmentAction) ActionResult {
+	result := ActionResult{
+		Environment: environmentID,
+	}
+
+	event, err := action(environmentID)
+	if err != nil {
+		result.Status, result.Error = ActionFailed, err.Error()
+
+		return result
+	}
+
+	result.Event = event.GetId()
+
+	if !wait {
+		result.Status = ActionScheduled
+
+		return result
+	}
+
+	// concurrent progress output would interleave
+	progressOptions := progress.NewOptions()
+	progressOptions.Silent = true
+
+	pipeline, err := progress.EventToPipeline(event, progressOptions)
+	if err == nil {
+		err = progress.Pipeline(pipeline.GetId(), progressOptions)
+	}
+
+	if err != nil {
+		result.Status, result.Error = ActionFailed, err.Error()
+
+		return result
+	}
+
+	result.Status = ActionSucceeded
+
+	return result
+}
@@ -0,0 +1,61 @@
+package environment
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"bunnyshell.com/cli/pkg/api/event"
+	bunnysdk "bunnyshell.com/sdk"
+)
+
+const createEventType = "env_create"
+
+var ErrCreationDateUnavailable = errors.New("the creation date of the environment is not available, --older-than cannot be applied")
+
+// SelectOlderThan keeps the environments created before now - age.
+// A bulk deletion must not guess, so an environment without a known creation date fails the whole selection.
+func SelectOlderThan(environments []bunnysdk.EnvironmentCollection, age time.Duration, now time.Time) ([]bunnysdk.EnvironmentCollection, error) {
+	if age <= 0 {
+		return environments, nil
+	}
+
+	threshold := now.Add(-age)
+	selected := []bunnysdk.EnvironmentCollection{}
+
+	for _, item := range environments {
+		createdAt, err := getCreationDate(item.GetId())
+		if err != nil {
+			return nil, err
+		}
+
+		if createdAt.Before(threshold) {
+			selected = append(selected, item)
+		}
+	}
+
+	return selected, nil
+}
+
+// getCreationDate reads the creation date from the create event, the environment models do not hold it.
+func getCreationDate(environmentID string) (time.Time, error) {
+	listOptions := event.NewListOptions()
+	listOptions.Environment = environmentID
+	listOptions.Type = createEventType
+
+	model, err := event.List(listOptions)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if !model.HasEmbedded() || len(model.Embedded.Item) == 0 {
+		return time.Time{}, fmt.Errorf("%w: %s has no create event", ErrCreationDateUnavailable, environmentID)
+	}
+
+	createdAt := model.Embedded.Item[0].GetCreatedAt()
+	if createdAt.IsZero() {
+		return time.Time{}, fmt.Errorf("%w: %s", ErrCreationDateUnavailable, environmentID)
+	}
+
+	return createdAt, nil
+}
@@ -0,0 +1,136 @@
+package environment
+
+import (
+	"fmt"
+	"sort"
+	"text/tabwriter"
+
+	bunnysdk "bunnyshell.com/sdk"
+	"gopkg.in/yaml.v3"
+)
+
+type PlanComponent struct {
+	Name string `json:"name" yaml:"name"`
+	Kind string `json:"kind" yaml:"kind"`
+}
+
+// CreatePlan describes what "environment create" would send, without creating anything.
+type CreatePlan struct {
+	Name                  string `json:"name" yaml:"name"`
+	Project               string `json:"project" yaml:"project"`
+	KubernetesIntegration string `json:"kubernetesIntegration,omitempty" yaml:"kubernetesIntegration,omitempty"`
+
+	Source string `json:"source" yaml:"source"`
+
+	Labels map[string]string `json:"labels,omitempty" yaml:"labels,omitempty"`
+
+	Components []PlanComponent   `json:"components,omitempty" yaml:"components,omitempty"`
+	Variables  map[string]string `json:"variables,omitempty" yaml:"variables,omitempty"`
+	Violations ValidationReport  `json:"violations,omitempty" yaml:"violations,omitempty"`
+}
+
+type planDefinition struct {
+	Components []PlanComponent `yaml:"components"`
+
+	EnvironmentVariables map[string]interface{} `yaml:"environmentVariables"`
+}
+
+func (plan *CreatePlan) IsValid() bool {
+	return plan.Violations.IsValid()
+}
+
+func (plan *CreatePlan) Tabulate(w *tabwriter.Writer) {
+	fmt.Fprintf(w, "%v\t %v\n", "Name", plan.Name)
+	fmt.Fprintf(w, "%v\t %v\n", "ProjectID", plan.Project)
+	fmt.Fprintf(w, "%v\t %v\n", "KubernetesIntegration", plan.KubernetesIntegration)
+	fmt.Fprintf(w, "%v\t %v\n", "Source", plan.Source)
+
+	for _, key := range sortedKeys(plan.Labels) {
+		fmt.Fprintf(w, "%v\t %v\n", "Label", key+"="+plan.Labels[key])
+	}
+
+	if len(plan.Components) > 0 {
+		fmt.Fprintf(w, "\n%v\t %v\n", "Component", "Kind")
+
+		for _, item := range plan.Components {
+			fmt.Fprintf(w, "%v\t %v\n", item.Name, item.Kind)
+		}
+	}
+
+	if len(plan.Variables) > 0 {
+		fmt.Fprintf(w, "\n%v\t %v\n", "Variable", "Value")
+
+		for _, key := range sortedKeys(plan.Variables) {
+			fmt.Fprintf(w, "%v\t %v\n", key, plan.Variables[key])
+		}
+	}
+
+	if !plan.IsValid() {
+		// flush the plan so the violations align on their own
+		w.Flush()
+
+		fmt.Fprintln(w)
+		plan.Violations.Tabulate(w)
+	}
+}
+
+// PlanCreate resolves the create payload, validating the definition locally when it is sent as yaml.
+func PlanCreate(action *bunnysdk.EnvironmentCreateAction) *CreatePlan {
+	plan := &CreatePlan{
+		Name:                  action.GetName(),
+		Project:               action.GetProject(),
+		KubernetesIntegration: action.GetKubernetesIntegration(),
+
+		Labels: action.GetLabels(),
+	}
+
+	genesis := action.Genesis
+	if genesis == nil {
+		return plan
+	}
+
+	switch {
+	case genesis.FromTemplate != nil:
+		plan.Source = "template " + genesis.FromTemplate.GetTemplate()
+	case genesis.FromGitSpec != nil:
+		plan.Source = "git " + genesis.FromGitSpec.GetSpec()
+	case genesis.FromGit != nil:
+		plan.Source = fmt.Sprintf("git %s@%s:%s", genesis.FromGit.GetUrl(), genesis.FromGit.GetBranch(), genesis.FromGit.GetYamlPath())
+	case genesis.FromString != nil:
+		plan.Source = "yaml"
+
+		loadPlanDefinition(plan, []byte(genesis.FromString.GetYaml()))
+	}
+
+	return plan
+}
+
+func loadPlanDefinition(plan *CreatePlan, content []byte) {
+	plan.Violations = ValidateDefinition(content)
+
+	definition := planDefinition{}
+	if err := yaml.Unmarshal(content, &definition); err != nil {
+		return
+	}
+
+	plan.Components = definition.Components
+
+	if len(definition.EnvironmentVariables) > 0 {
+		plan.Variables = map[string]string{}
+
+		for name, value := range definition.EnvironmentVariables {
+			plan.Variables[name] = fmt.Sprint(value)
+		}
+	}
+}
+
+func sortedKeys(data map[string]string) []string {
+	keys := make([]string, 0, len(data))
+	for key := range data {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,67 @@
+package environment
+
+import (
+	"fmt"
+	"text/tabwriter"
+
+	"bunnyshell.com/cli/pkg/api/component"
+	"bunnyshell.com/cli/pkg/api/component/endpoint"
+	"bunnyshell.com/cli/pkg/api/environment"
+	"bunnyshell.com/cli/pkg/formatter"
+	bunnysdk "bunnyshell.com/sdk"
+)
+
+type Description struct {
+	Environment *bunnysdk.EnvironmentItem `json:"environment" yaml:"environment"`
+
+	Components []bunnysdk.ComponentCollection         `json:"components" yaml:"components"`
+	Endpoints  []bunnysdk.ComponentEndpointCollection `json:"endpoints" yaml:"endpoints"`
+}
+
+// Tabulate renders the environment and endpoints tables, with the components in between.
+func (description *Description) Tabulate(w *tabwriter.Writer) {
+	formatter.WriteStylish(w, description.Environment)
+
+	fmt.Fprintf(w, "\n%v\t %v\t %v\t %v\n", "ComponentID", "Name", "OperationStatus", "ClusterStatus")
+
+	for _, item := range description.Components {
+		fmt.Fprintf(w, "%v\t %v\t %v\t %v\n", item.GetId(), item.GetName(), item.GetOperationStatus(), item.GetClusterStatus())
+	}
+
+	// flush the components table so it aligns on its own
+	w.Flush()
+
+	fmt.Fprintln(w)
+	formatter.WriteStylish(w, description.Endpoints)
+}
+
+// Describe fetches the environment along with its components and public endpoints.
+func Describe(environmentID string) (*Description, error) {
+	model, err := environment.Get(environment.NewItemOptions(environmentID))
+	if err != nil {
+		return nil, err
+	}
+
+	listOptions := component.NewListOptions()
+	listOptions.Environment = environmentID
+
+	components, err := component.ListAll(*listOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	endpointOptions := endpoint.NewAggregateOptions()
+	endpointOptions.Environment = environmentID
+
+	endpoints, err := endpoint.Aggregate(endpointOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Description{
+		Environment: model,
+
+		Components: components,
+		Endpoints:  endpoints,
+	}, nil
+}
@@ -123,7 +123,7 @@ func (r *EnvironmentResource) SelectOrganization() error {
 		items = append(items, item.GetName())
 	}
 
-	index, _, err := interactive.Choose("Select organization", items)
+	index, err := chooseContextItem("Select organization", items, model.GetTotalItems())
 	if err != nil {
 		return err
 	}
@@ -158,7 +158,7 @@ func (r *EnvironmentResource) SelectProject() error {
 		items = append(items, item.GetName())
 	}
 
-	index, _, err := interactive.Choose("Select project", items)
+	index, err := chooseContextItem("Select project", items, resp.GetTotalItems())
 	if err != nil {
 		return err
 	}
@@ -193,7 +193,7 @@ func (r *EnvironmentResource) SelectEnvironment() error {
 		items = append(items, item.GetName())
 	}
 
-	index, _, err := interactive.Choose("Select environment", items)
+	index, err := chooseContextItem("Select environment", items, resp.GetTotalItems())
 	if err != nil {
 		return err
 	}
@@ -231,7 +231,7 @@ func (r *EnvironmentResource) SelectComponent() error {
 		items = append(items, item.GetName())
 	}
 
-	index, _, err := interactive.Choose("Select component", items)
+	index, err := chooseContextItem("Select component", items, resp.GetTotalItems())
 	if err != nil {
 		return err
 	}
@@ -291,3 +291,13 @@ func (r *EnvironmentResource) SelectComponentResource() error {
 
 	return nil
 }
+
+func chooseContextItem(question string, items []string, total int32) (int, error) {
+	if config.GetSettings().ShouldAutoSelect(total) && len(items) == 1 {
+		return 0, nil
+	}
+
+	index, _, err := interactive.Choose(question, items)
+
+	return index, err
+}
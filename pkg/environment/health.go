@@ -0,0 +1,208 @@
+package environment
+
+import (
+	"fmt"
+	"sync"
+	"text/tabwriter"
+
+	"bunnyshell.com/cli/pkg/api/component"
+	"bunnyshell.com/cli/pkg/api/environment"
+	"bunnyshell.com/cli/pkg/api/pipeline"
+	"bunnyshell.com/cli/pkg/net"
+	"bunnyshell.com/cli/pkg/progress"
+	bunnysdk "bunnyshell.com/sdk"
+)
+
+const componentReadyStatus = "running"
+
+type HealthItem struct {
+	Environment string `json:"environment" yaml:"environment"`
+	Project     string `json:"project" yaml:"project"`
+	Name        string `json:"name" yaml:"name"`
+
+	OperationStatus    string `json:"operationStatus" yaml:"operationStatus"`
+	LastPipelineStatus string `json:"lastPipelineStatus,omitempty" yaml:"lastPipelineStatus,omitempty"`
+
+	ReadyComponents int `json:"readyComponents" yaml:"readyComponents"`
+	TotalComponents int `json:"totalComponents" yaml:"totalComponents"`
+
+	Healthy bool   `json:"healthy" yaml:"healthy"`
+	Error   string `json:"error,omitempty" yaml:"error,omitempty"`
+}
+
+type HealthReport []HealthItem
+
+func (report HealthReport) IsHealthy() bool {
+	for _, item := range report {
+		if !item.Healthy {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (report HealthReport) Tabulate(w *tabwriter.Writer) {
+	fmt.Fprintf(w, "%v\t %v\t %v\t %v\t %v\t %v\t %v\n", "EnvironmentID", "ProjectID", "Name", "OperationStatus", "Ready", "LastPipeline", "Health")
+
+	for _, item := range report {
+		health := "healthy"
+		if !item.Healthy {
+			health = "unhealthy"
+		}
+
+		if item.Error != "" {
+			health = "unknown: " + item.Error
+		}
+
+		fmt.Fprintf(
+			w,
+			"%v\t %v\t %v\t %v\t %v\t %v\t %v\n",
+			item.Environment,
+			item.Project,
+			item.Name,
+			item.OperationStatus,
+			fmt.Sprintf("%d/%d", item.ReadyComponents, item.TotalComponents),
+			item.LastPipelineStatus,
+			health,
+		)
+	}
+}
+
+// CheckHealth fetches the components and last pipeline of the environments, at most concurrency at a time.
+func CheckHealth(environments []bunnysdk.EnvironmentCollection, concurrency int) HealthReport {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	// the spinner is not safe for concurrent requests
+	resume := net.PauseSpinner()
+	defer resume()
+
+	report := make(HealthReport, len(environments))
+
+	semaphore := make(chan struct{}, concurrency)
+
+	var wg sync.WaitGroup
+
+	for index, environmentItem := range environments {
+		wg.Add(1)
+
+		go func(index int, environmentItem bunnysdk.EnvironmentCollection) {
+			defer wg.Done()
+
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			report[index] = getHealth(environmentItem)
+		}(index, environmentItem)
+	}
+
+	wg.Wait()
+
+	return report
+}
+
+// GetHealth checks a single environment, like CheckHealth does for a listing.
+func GetHealth(environmentID string) (*HealthItem, error) {
+	model, err := environment.Get(environment.NewItemOptions(environmentID))
+	if err != nil {
+		return nil, err
+	}
+
+	item := HealthItem{
+		Environment: model.GetId(),
+		Project:     model.GetProject(),
+		Name:        model.GetName(),
+
+		OperationStatus: model.GetOperationStatus(),
+	}
+
+	if err = loadHealth(&item); err != nil {
+		return nil, err
+	}
+
+	return &item, nil
+}
+
+func (item *HealthItem) Tabulate(w *tabwriter.Writer) {
+	HealthReport{*item}.Tabulate(w)
+}
+
+func getHealth(environmentItem bunnysdk.EnvironmentCollection) HealthItem {
+	item := HealthItem{
+		Environment: environmentItem.GetId(),
+		Project:     environmentItem.GetProject(),
+		Name:        environmentItem.GetName(),
+
+		OperationStatus: environmentItem.GetOperationStatus(),
+	}
+
+	if err := loadHealth(&item); err != nil {
+		item.Error = err.Error()
+	}
+
+	return item
+}
+
+func loadHealth(item *HealthItem) error {
+	if err := loadComponentsHealth(item); err != nil {
+		return err
+	}
+
+	lastPipelineStatus, err := getLastPipelineStatus(item.Environment)
+	if err != nil {
+		return err
+	}
+
+	item.LastPipelineStatus = lastPipelineStatus
+	item.Healthy = item.ReadyComponents == item.TotalComponents && item.LastPipelineStatus != progress.StatusFailed
+
+	return nil
+}
+
+func loadComponentsHealth(item *HealthItem) error {
+	listOptions := component.NewListOptions()
+	listOptions.Environment = item.Environment
+
+	for {
+		model, err := component.List(listOptions)
+		if err != nil {
+			return err
+		}
+
+		if model.HasEmbedded() {
+			for _, componentItem := range model.Embedded.Item {
+				item.TotalComponents++
+
+				if componentItem.GetClusterStatus() == componentReadyStatus {
+					item.ReadyComponents++
+				}
+			}
+		}
+
+		if !model.HasLinks() || !model.Links.HasNext() {
+			return nil
+		}
+
+		listOptions.Page++
+	}
+}
+
+// getLastPipelineStatus returns the progress status of the newest pipeline, empty when there is none.
+func getLastPipelineStatus(environmentID string) (string, error) {
+	listOptions := pipeline.NewListOptions()
+	listOptions.Environment = environmentID
+
+	model, err := pipeline.List(listOptions)
+	if err != nil {
+		return "", err
+	}
+
+	// the pipelines are listed newest first
+	if !model.HasEmbedded() || len(model.Embedded.Item) == 0 {
+		return "", nil
+	}
+
+	return model.Embedded.Item[0].GetStatus(), nil
+}
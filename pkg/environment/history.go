@@ -0,0 +1,176 @@
+package environment
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"bunnyshell.com/cli/pkg/api/environment"
+	"bunnyshell.com/cli/pkg/util"
+)
+
+const (
+	historyDirname   = "history"
+	revisionFileExt  = ".yaml"
+	historyDirPerm   = 0o700
+	revisionFilePerm = 0o600
+)
+
+var ErrUnknownRevision = errors.New("unknown revision")
+
+type Revision struct {
+	Number int `json:"number" yaml:"number"`
+
+	CreatedAt time.Time `json:"createdAt" yaml:"createdAt"`
+	Size      int64     `json:"size" yaml:"size"`
+
+	file string
+}
+
+// Revisions are the definitions an environment had before being changed from this machine, oldest first.
+type Revisions []Revision
+
+func (revisions Revisions) Tabulate(w *tabwriter.Writer) {
+	if len(revisions) == 0 {
+		fmt.Fprintln(w, "No revisions recorded, they are saved before each configuration update.")
+
+		return
+	}
+
+	fmt.Fprintf(w, "%v\t %v\t %v\n", "Revision", "SavedAt", "Size")
+
+	for _, revision := range revisions {
+		fmt.Fprintf(w, "%v\t %v\t %v\n", revision.Number, revision.CreatedAt.Format(time.RFC3339), revision.Size)
+	}
+}
+
+func (revisions Revisions) Last() *Revision {
+	if len(revisions) == 0 {
+		return nil
+	}
+
+	return &revisions[len(revisions)-1]
+}
+
+// RecordRevision saves the current definition of the environment, unless it matches the last saved one.
+func RecordRevision(environmentID string) (*Revision, error) {
+	definition, err := environment.Definition(environment.NewDefinitionOptions(environmentID))
+	if err != nil {
+		return nil, err
+	}
+
+	revisions, err := ListRevisions(environmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	number := 1
+
+	if last := revisions.Last(); last != nil {
+		content, err := os.ReadFile(last.file)
+		if err == nil && bytes.Equal(content, definition.Bytes) {
+			return last, nil
+		}
+
+		number = last.Number + 1
+	}
+
+	dir, err := getHistoryDir(environmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = os.MkdirAll(dir, historyDirPerm); err != nil {
+		return nil, err
+	}
+
+	file := filepath.Join(dir, strconv.Itoa(number)+revisionFileExt)
+	if err = util.WriteFileAtomic(file, definition.Bytes, revisionFilePerm); err != nil {
+		return nil, err
+	}
+
+	return &Revision{
+		Number: number,
+
+		CreatedAt: time.Now(),
+		Size:      int64(len(definition.Bytes)),
+
+		file: file,
+	}, nil
+}
+
+func ListRevisions(environmentID string) (Revisions, error) {
+	dir, err := getHistoryDir(environmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return Revisions{}, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	revisions := Revisions{}
+
+	for _, entry := range entries {
+		number, err := strconv.Atoi(strings.TrimSuffix(entry.Name(), revisionFileExt))
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), revisionFileExt) || err != nil {
+			continue
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			return nil, err
+		}
+
+		revisions = append(revisions, Revision{
+			Number: number,
+
+			CreatedAt: info.ModTime(),
+			Size:      info.Size(),
+
+			file: filepath.Join(dir, entry.Name()),
+		})
+	}
+
+	sort.Slice(revisions, func(i, j int) bool {
+		return revisions[i].Number < revisions[j].Number
+	})
+
+	return revisions, nil
+}
+
+func ReadRevision(environmentID string, number int) ([]byte, error) {
+	revisions, err := ListRevisions(environmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, revision := range revisions {
+		if revision.Number == number {
+			return os.ReadFile(revision.file)
+		}
+	}
+
+	return nil, fmt.Errorf("%w %d for environment %s", ErrUnknownRevision, number, environmentID)
+}
+
+func getHistoryDir(environmentID string) (string, error) {
+	workspace, err := util.GetWorkspaceDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(workspace, historyDirname, environmentID), nil
+}
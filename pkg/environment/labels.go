@@ -0,0 +1,22 @@
+package environment
+
+import (
+	"fmt"
+	"text/tabwriter"
+)
+
+type Labels map[string]string
+
+func (labels Labels) Tabulate(w *tabwriter.Writer) {
+	if len(labels) == 0 {
+		fmt.Fprintln(w, "Environment has no labels")
+
+		return
+	}
+
+	fmt.Fprintf(w, "%v\t %v\n", "Key", "Value")
+
+	for _, key := range sortedKeys(labels) {
+		fmt.Fprintf(w, "%v\t %v\n", key, labels[key])
+	}
+}
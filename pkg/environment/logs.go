@@ -0,0 +1,301 @@
+package environment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"bunnyshell.com/cli/pkg/api/component"
+	"bunnyshell.com/cli/pkg/api/environment"
+	"bunnyshell.com/cli/pkg/k8s"
+	"github.com/fatih/color"
+	coreV1 "k8s.io/api/core/v1"
+	apiMetaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var (
+	errNoLogSources          = errors.New("no running containers found for the environment components")
+	errNoComponentLogSources = errors.New("no running containers found for the component")
+	errLogStreamsFailed      = errors.New("some container logs could not be streamed")
+)
+
+// component resources which own pods
+var logResourceKinds = map[string]bool{
+	k8s.DeploymentKind:  true,
+	k8s.StatefulSetKind: true,
+	k8s.DaemonSetKind:   true,
+	k8s.JobKind:         true,
+	k8s.CronJobKind:     true,
+	k8s.PodKind:         true,
+}
+
+var logPrefixColors = []color.Attribute{
+	color.FgCyan,
+	color.FgYellow,
+	color.FgGreen,
+	color.FgMagenta,
+	color.FgBlue,
+	color.FgRed,
+}
+
+type LogsOptions struct {
+	// Components limits the logs to the named components, all of them when empty
+	Components []string
+
+	// Container limits the logs to the named container of each pod, all of them when empty
+	Container string
+
+	Follow bool
+	Tail   int64
+	Since  time.Duration
+}
+
+type logSource struct {
+	prefix string
+
+	pod       *coreV1.Pod
+	container string
+}
+
+// prefixWriter serializes the lines of concurrent streams, so they do not interleave.
+type prefixWriter struct {
+	mutex sync.Mutex
+
+	out   io.Writer
+	width int
+}
+
+func (writer *prefixWriter) writeLine(prefix *color.Color, name string, line string) {
+	writer.mutex.Lock()
+	defer writer.mutex.Unlock()
+
+	fmt.Fprintf(writer.out, "%s %s\n", prefix.Sprintf("%-*s |", writer.width, name), line)
+}
+
+// StreamLogs multiplexes the container logs of the environment components into out, prefixed by their origin.
+func StreamLogs(ctx context.Context, environmentID string, options LogsOptions, out io.Writer) error {
+	kubeConfig, err := environment.KubeConfig(environment.NewKubeConfigOptions(environmentID))
+	if err != nil {
+		return err
+	}
+
+	client, err := k8s.NewKubernetesClientFromBytes(kubeConfig.Bytes)
+	if err != nil {
+		return err
+	}
+
+	sources, err := getLogSources(client, environmentID, options)
+	if err != nil {
+		return err
+	}
+
+	if len(sources) == 0 {
+		return errNoLogSources
+	}
+
+	return streamLogSources(ctx, client, sources, options, out)
+}
+
+// StreamComponentLogs writes the container logs of a single component into out, prefixed by their origin.
+func StreamComponentLogs(ctx context.Context, componentID string, options LogsOptions, out io.Writer) error {
+	item, err := component.Get(component.NewItemOptions(componentID))
+	if err != nil {
+		return err
+	}
+
+	kubeConfig, err := environment.KubeConfig(environment.NewKubeConfigOptions(item.GetEnvironment()))
+	if err != nil {
+		return err
+	}
+
+	client, err := k8s.NewKubernetesClientFromBytes(kubeConfig.Bytes)
+	if err != nil {
+		return err
+	}
+
+	pods, err := getComponentPods(client, item.GetId())
+	if err != nil {
+		return err
+	}
+
+	sources := getPodLogSources(item.GetName(), pods, options.Container)
+	if len(sources) == 0 {
+		return errNoComponentLogSources
+	}
+
+	return streamLogSources(ctx, client, sources, options, out)
+}
+
+// streamLogSources reports the failed streams inline, along with an error once all of them are done.
+func streamLogSources(ctx context.Context, client *k8s.KubernetesClient, sources []logSource, options LogsOptions, out io.Writer) error {
+	writer := &prefixWriter{out: out}
+	for _, source := range sources {
+		writer.width = max(writer.width, len(source.prefix))
+	}
+
+	failures := make([]error, len(sources))
+
+	var wg sync.WaitGroup
+
+	for index, source := range sources {
+		wg.Add(1)
+
+		go func(index int, source logSource, prefix *color.Color) {
+			defer wg.Done()
+
+			err := client.StreamLogs(ctx, source.pod, getPodLogOptions(source.container, options), func(line string) {
+				writer.writeLine(prefix, source.prefix, line)
+			})
+			// interrupting a follow is not a failure
+			if err != nil && ctx.Err() == nil {
+				writer.writeLine(prefix, source.prefix, "error: "+err.Error())
+
+				failures[index] = fmt.Errorf("%s: %w", source.prefix, err)
+			}
+		}(index, source, color.New(logPrefixColors[index%len(logPrefixColors)]))
+	}
+
+	wg.Wait()
+
+	failed := 0
+
+	for _, err := range failures {
+		if err != nil {
+			failed++
+		}
+	}
+
+	if failed == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("%w: %d of %d\n%w", errLogStreamsFailed, failed, len(sources), errors.Join(failures...))
+}
+
+func getPodLogOptions(container string, options LogsOptions) *coreV1.PodLogOptions {
+	logOptions := &coreV1.PodLogOptions{
+		Container: container,
+		Follow:    options.Follow,
+	}
+
+	if options.Tail >= 0 {
+		logOptions.TailLines = &options.Tail
+	}
+
+	if options.Since > 0 {
+		seconds := int64(options.Since.Seconds())
+		logOptions.SinceSeconds = &seconds
+	}
+
+	return logOptions
+}
+
+func getLogSources(client *k8s.KubernetesClient, environmentID string, options LogsOptions) ([]logSource, error) {
+	listOptions := component.NewListOptions()
+	listOptions.Environment = environmentID
+
+	components, err := component.ListAll(*listOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	wanted := map[string]bool{}
+	for _, name := range options.Components {
+		wanted[name] = true
+	}
+
+	sources := []logSource{}
+
+	for _, item := range components {
+		if len(wanted) > 0 && !wanted[item.GetName()] {
+			continue
+		}
+
+		pods, err := getComponentPods(client, item.GetId())
+		if err != nil {
+			return nil, fmt.Errorf("component %s: %w", item.GetName(), err)
+		}
+
+		sources = append(sources, getPodLogSources(item.GetName(), pods, options.Container)...)
+	}
+
+	return sources, nil
+}
+
+func getComponentPods(client *k8s.KubernetesClient, componentID string) ([]coreV1.Pod, error) {
+	resources, err := component.Resources(component.NewResourceOptions(componentID))
+	if err != nil {
+		return nil, err
+	}
+
+	pods := []coreV1.Pod{}
+
+	for _, resource := range resources {
+		if !logResourceKinds[strings.ToLower(resource.GetKind())] {
+			continue
+		}
+
+		resourcePods, err := client.ResourcePods(resource.GetNamespace(), resource.GetKind(), resource.GetName())
+		if err != nil {
+			return nil, err
+		}
+
+		for _, pod := range resourcePods {
+			// pending pods have no logs yet
+			if pod.Status.Phase == coreV1.PodPending || pod.DeletionTimestamp != nil {
+				continue
+			}
+
+			pods = append(pods, pod)
+		}
+	}
+
+	return pods, nil
+}
+
+// getPodLogSources names the sources after the component, adding the pod and container only when needed to tell them apart.
+func getPodLogSources(componentName string, pods []coreV1.Pod, containerName string) []logSource {
+	sources := []logSource{}
+
+	for index := range pods {
+		pod := &pods[index]
+
+		for _, container := range pod.Spec.Containers {
+			if containerName != "" && container.Name != containerName {
+				continue
+			}
+
+			prefix := componentName
+
+			if len(pods) > 1 {
+				prefix += "/" + shortPodName(pod.ObjectMeta)
+			}
+
+			if len(pod.Spec.Containers) > 1 {
+				prefix += "/" + container.Name
+			}
+
+			sources = append(sources, logSource{
+				prefix: prefix,
+
+				pod:       pod,
+				container: container.Name,
+			})
+		}
+	}
+
+	return sources
+}
+
+// shortPodName keeps the random suffix generated for the pod, the rest repeats the owner name.
+func shortPodName(meta apiMetaV1.ObjectMeta) string {
+	if meta.GenerateName == "" || !strings.HasPrefix(meta.Name, meta.GenerateName) {
+		return meta.Name
+	}
+
+	return meta.Name[len(meta.GenerateName):]
+}
@@ -0,0 +1,97 @@
+package environment
+
+import (
+	"fmt"
+	"text/tabwriter"
+
+	"bunnyshell.com/cli/pkg/api/environment"
+	k8sIntegration "bunnyshell.com/cli/pkg/api/k8s"
+	"bunnyshell.com/cli/pkg/k8s"
+)
+
+type NamespaceInfo struct {
+	Environment string `json:"environment" yaml:"environment"`
+	Namespace   string `json:"namespace" yaml:"namespace"`
+
+	KubernetesIntegration string `json:"kubernetesIntegration" yaml:"kubernetesIntegration"`
+	Cluster               string `json:"cluster" yaml:"cluster"`
+	Provider              string `json:"provider,omitempty" yaml:"provider,omitempty"`
+
+	Context string `json:"context,omitempty" yaml:"context,omitempty"`
+	Server  string `json:"server,omitempty" yaml:"server,omitempty"`
+
+	kubeConfig []byte
+}
+
+func (info *NamespaceInfo) Tabulate(w *tabwriter.Writer) {
+	fmt.Fprintf(w, "%v\t %v\n", "EnvironmentID", info.Environment)
+	fmt.Fprintf(w, "%v\t %v\n", "Namespace", info.Namespace)
+	fmt.Fprintf(w, "%v\t %v\n", "K8SIntegrationID", info.KubernetesIntegration)
+	fmt.Fprintf(w, "%v\t %v\n", "Cluster", info.Cluster)
+	fmt.Fprintf(w, "%v\t %v\n", "Provider", info.Provider)
+	fmt.Fprintf(w, "%v\t %v\n", "Context", info.Context)
+	fmt.Fprintf(w, "%v\t %v\n", "Server", info.Server)
+}
+
+// SetKubectlContext merges the environment kubeconfig into path, switching to its context within the namespace.
+func (info *NamespaceInfo) SetKubectlContext(path string) error {
+	_, err := k8s.MergeKubeConfig(path, info.kubeConfig, true)
+
+	return err
+}
+
+// GetKubeConfig returns the environment kubeconfig, its contexts defaulting to the environment namespace.
+func GetKubeConfig(options *environment.KubeConfigOptions) ([]byte, error) {
+	model, err := environment.Get(&options.ItemOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	return getKubeConfig(options, model.GetNamespace())
+}
+
+func getKubeConfig(options *environment.KubeConfigOptions, namespace string) ([]byte, error) {
+	kubeConfig, err := environment.KubeConfig(options)
+	if err != nil {
+		return nil, err
+	}
+
+	return k8s.WithNamespace(kubeConfig.Bytes, namespace)
+}
+
+// GetNamespaceInfo describes the namespace, cluster and integration the environment is deployed to.
+func GetNamespaceInfo(environmentID string) (*NamespaceInfo, error) {
+	model, err := environment.Get(environment.NewItemOptions(environmentID))
+	if err != nil {
+		return nil, err
+	}
+
+	info := &NamespaceInfo{
+		Environment: model.GetId(),
+		Namespace:   model.GetNamespace(),
+
+		KubernetesIntegration: model.GetKubernetesIntegration(),
+	}
+
+	if info.KubernetesIntegration == "" {
+		return info, nil
+	}
+
+	integration, err := k8sIntegration.Get(k8sIntegration.NewItemOptions(info.KubernetesIntegration))
+	if err != nil {
+		return nil, err
+	}
+
+	info.Cluster = integration.GetClusterName()
+	info.Provider = integration.GetCloudProvider()
+
+	if info.kubeConfig, err = getKubeConfig(environment.NewKubeConfigOptions(environmentID), info.Namespace); err != nil {
+		return nil, err
+	}
+
+	if info.Context, info.Server, err = k8s.CurrentContextServer(info.kubeConfig); err != nil {
+		return nil, err
+	}
+
+	return info, nil
+}
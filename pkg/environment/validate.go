@@ -0,0 +1,198 @@
+package environment
+
+import (
+	"fmt"
+	"sort"
+	"text/tabwriter"
+
+	"bunnyshell.com/cli/pkg/util"
+	"gopkg.in/yaml.v3"
+)
+
+const definitionKind = "Environment"
+
+var definitionTypes = map[string]bool{
+	"primary":   true,
+	"ephemeral": true,
+}
+
+type Violation struct {
+	Line int    `json:"line" yaml:"line"`
+	Path string `json:"path" yaml:"path"`
+
+	Message string `json:"message" yaml:"message"`
+}
+
+type ValidationReport []Violation
+
+func (report ValidationReport) IsValid() bool {
+	return len(report) == 0
+}
+
+func (report ValidationReport) Tabulate(w *tabwriter.Writer) {
+	if report.IsValid() {
+		fmt.Fprintln(w, "Definition is valid.")
+
+		return
+	}
+
+	fmt.Fprintf(w, "%v\t %v\t %v\n", "Line", "Path", "Message")
+
+	for _, violation := range report {
+		fmt.Fprintf(w, "%v\t %v\t %v\n", violation.Line, violation.Path, violation.Message)
+	}
+}
+
+// ValidateDefinition checks the structure of an environment definition without contacting the API.
+func ValidateDefinition(content []byte) ValidationReport {
+	document := yaml.Node{}
+	if err := yaml.Unmarshal(content, &document); err != nil {
+		return ValidationReport{{Path: "", Message: err.Error()}}
+	}
+
+	report := ValidationReport{}
+
+	if len(document.Content) == 0 {
+		return append(report, Violation{Line: 1, Path: "", Message: "definition is empty"})
+	}
+
+	root := document.Content[0]
+	if root.Kind != yaml.MappingNode {
+		return append(report, Violation{Line: root.Line, Path: "", Message: "definition must be a mapping"})
+	}
+
+	report.checkScalar(root, "kind", "kind", true)
+	report.checkScalar(root, "name", "name", true)
+	report.checkScalar(root, "type", "type", false)
+
+	if kind := util.FindKey(root, "kind"); kind != nil && kind.Kind == yaml.ScalarNode && kind.Value != definitionKind {
+		report.add(kind, "kind", fmt.Sprintf("must be %q", definitionKind))
+	}
+
+	if definitionType := util.FindKey(root, "type"); definitionType != nil && definitionType.Kind == yaml.ScalarNode && !definitionTypes[definitionType.Value] {
+		report.add(definitionType, "type", "must be one of primary, ephemeral")
+	}
+
+	report.checkVariables(util.FindKey(root, "environmentVariables"), "environmentVariables")
+
+	if components := util.FindKey(root, "components"); components != nil {
+		report.checkComponents(components)
+	} else {
+		report.add(root, "components", "is required")
+	}
+
+	sort.SliceStable(report, func(i, j int) bool {
+		return report[i].Line < report[j].Line
+	})
+
+	return report
+}
+
+func (report *ValidationReport) checkComponents(components *yaml.Node) {
+	if components.Kind != yaml.SequenceNode {
+		report.add(components, "components", "must be a list")
+
+		return
+	}
+
+	names := map[string]bool{}
+
+	for index, item := range components.Content {
+		path := fmt.Sprintf("components[%d]", index)
+
+		if item.Kind != yaml.MappingNode {
+			report.add(item, path, "must be a mapping")
+
+			continue
+		}
+
+		report.checkScalar(item, "kind", path+".kind", true)
+		report.checkScalar(item, "name", path+".name", true)
+		report.checkVariables(util.FindKey(item, "environment"), path+".environment")
+
+		if name := util.FindKey(item, "name"); name != nil && name.Kind == yaml.ScalarNode {
+			if names[name.Value] {
+				report.add(name, path+".name", fmt.Sprintf("duplicate component name %q", name.Value))
+			}
+
+			names[name.Value] = true
+		}
+	}
+
+	// dependencies may reference components declared later in the list
+	for index, item := range components.Content {
+		if item.Kind != yaml.MappingNode {
+			continue
+		}
+
+		report.checkDependencies(util.FindKey(item, "dependsOn"), fmt.Sprintf("components[%d].dependsOn", index), names)
+	}
+}
+
+func (report *ValidationReport) checkDependencies(dependencies *yaml.Node, path string, names map[string]bool) {
+	if dependencies == nil {
+		return
+	}
+
+	if dependencies.Kind != yaml.SequenceNode {
+		report.add(dependencies, path, "must be a list")
+
+		return
+	}
+
+	for index, dependency := range dependencies.Content {
+		if dependency.Kind != yaml.ScalarNode {
+			report.add(dependency, fmt.Sprintf("%s[%d]", path, index), "must be a component name")
+
+			continue
+		}
+
+		if !names[dependency.Value] {
+			report.add(dependency, fmt.Sprintf("%s[%d]", path, index), fmt.Sprintf("unknown component %q", dependency.Value))
+		}
+	}
+}
+
+func (report *ValidationReport) checkVariables(variables *yaml.Node, path string) {
+	if variables == nil {
+		return
+	}
+
+	if variables.Kind != yaml.MappingNode {
+		report.add(variables, path, "must be a mapping")
+
+		return
+	}
+
+	for index := 0; index+1 < len(variables.Content); index += 2 {
+		name, value := variables.Content[index], variables.Content[index+1]
+
+		if value.Kind != yaml.ScalarNode {
+			report.add(value, path+"."+name.Value, "must be a scalar value")
+		}
+	}
+}
+
+func (report *ValidationReport) checkScalar(node *yaml.Node, key string, path string, required bool) {
+	value := util.FindKey(node, key)
+	if value == nil {
+		if required {
+			report.add(node, path, "is required")
+		}
+
+		return
+	}
+
+	if value.Kind != yaml.ScalarNode || value.Value == "" {
+		report.add(value, path, "must be a non-empty string")
+	}
+}
+
+func (report *ValidationReport) add(node *yaml.Node, path string, message string) {
+	*report = append(*report, Violation{
+		Line: node.Line,
+		Path: path,
+
+		Message: message,
+	})
+}
@@ -0,0 +1,162 @@
+package environment
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"bunnyshell.com/cli/pkg/api"
+	"bunnyshell.com/cli/pkg/progress"
+)
+
+type WaitCondition string
+
+const (
+	WaitRunning  WaitCondition = "running"
+	WaitStopped  WaitCondition = "stopped"
+	WaitDeployed WaitCondition = "deployed"
+	WaitDeleted  WaitCondition = "deleted"
+)
+
+var WaitConditions = []WaitCondition{WaitRunning, WaitStopped, WaitDeployed, WaitDeleted}
+
+var (
+	ErrUnknownWaitCondition = errors.New("unknown wait condition")
+	ErrWaitFailed           = errors.New("environment operation failed")
+	ErrWaitTimeout          = errors.New("environment did not reach the condition in time")
+)
+
+const (
+	clusterStatusRunning = "running"
+	clusterStatusStopped = "stopped"
+)
+
+type WaitOptions struct {
+	Condition WaitCondition
+
+	Interval time.Duration
+	Timeout  time.Duration
+
+	// OnChange is called with each new status, nil once the environment is gone
+	OnChange func(status *Status)
+}
+
+func ParseWaitCondition(value string) (WaitCondition, error) {
+	for _, condition := range WaitConditions {
+		if string(condition) == value {
+			return condition, nil
+		}
+	}
+
+	return "", fmt.Errorf("%w %q, expected one of %s", ErrUnknownWaitCondition, value, JoinWaitConditions())
+}
+
+func JoinWaitConditions() string {
+	names := make([]string, 0, len(WaitConditions))
+	for _, condition := range WaitConditions {
+		names = append(names, string(condition))
+	}
+
+	return strings.Join(names, ", ")
+}
+
+// IsMet reports the condition is reached, a nil status meaning the environment does not exist anymore.
+func (condition WaitCondition) IsMet(status *Status) bool {
+	if status == nil {
+		return condition == WaitDeleted
+	}
+
+	if !status.IsSettled() {
+		return false
+	}
+
+	switch condition {
+	case WaitRunning:
+		return status.ClusterStatus == clusterStatusRunning
+	case WaitStopped:
+		return status.ClusterStatus == clusterStatusStopped
+	case WaitDeployed:
+		// a stopped environment keeps its last successful pipeline, it is not deployed anymore
+		return status.LastPipelineStatus == progress.StatusSuccess && status.ClusterStatus != clusterStatusStopped
+	}
+
+	return false
+}
+
+// HasFailed reports the last operation failed, the condition cannot be reached without a new one.
+func HasFailed(status *Status) bool {
+	if status == nil || !status.IsSettled() {
+		return false
+	}
+
+	if status.LastPipelineStatus == progress.StatusFailed {
+		return true
+	}
+
+	return strings.Contains(status.OperationStatus, "fail") || strings.Contains(status.ClusterStatus, "fail")
+}
+
+// Wait polls the environment until the condition is met, the last operation failed or the timeout is reached.
+func Wait(environmentID string, options WaitOptions) (*Status, error) {
+	var (
+		previous *Status
+		deadline time.Time
+	)
+
+	if options.Timeout > 0 {
+		deadline = time.Now().Add(options.Timeout)
+	}
+
+	for first := true; ; first = false {
+		status, err := getWaitStatus(environmentID, options.Condition)
+		if err != nil {
+			return nil, err
+		}
+
+		if options.OnChange != nil && (first || status == nil || !status.Equal(previous)) {
+			options.OnChange(status)
+		}
+
+		if options.Condition.IsMet(status) {
+			return status, nil
+		}
+
+		if status == nil {
+			return nil, fmt.Errorf("%w: environment %s does not exist", ErrWaitFailed, environmentID)
+		}
+
+		if HasFailed(status) {
+			return status, fmt.Errorf("%w: %s", ErrWaitFailed, status.OperationStatus)
+		}
+
+		if !deadline.IsZero() && time.Now().After(deadline) {
+			return status, ErrWaitTimeout
+		}
+
+		previous = status
+
+		time.Sleep(options.Interval)
+	}
+}
+
+// getWaitStatus fetches the status, nil once the environment is gone.
+// The deployed condition also needs the outcome of the last pipeline.
+func getWaitStatus(environmentID string, condition WaitCondition) (*Status, error) {
+	status, err := GetStatus(environmentID)
+	if err != nil {
+		if api.IsNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	if condition == WaitDeployed {
+		if status.LastPipelineStatus, err = getLastPipelineStatus(environmentID); err != nil {
+			return nil, err
+		}
+	}
+
+	return status, nil
+}
@@ -0,0 +1,117 @@
+package environment
+
+import (
+	"fmt"
+	"text/tabwriter"
+
+	"bunnyshell.com/cli/pkg/api/component"
+	"bunnyshell.com/cli/pkg/api/environment"
+)
+
+// operation statuses of an environment which is still being worked on
+var transitionalStatuses = map[string]bool{
+	"queued":    true,
+	"deploying": true,
+	"deleting":  true,
+	"starting":  true,
+	"stopping":  true,
+	"aborting":  true,
+}
+
+type ComponentStatus struct {
+	Name string `json:"name" yaml:"name"`
+
+	OperationStatus string `json:"operationStatus" yaml:"operationStatus"`
+	ClusterStatus   string `json:"clusterStatus" yaml:"clusterStatus"`
+}
+
+type Status struct {
+	Environment string `json:"environment" yaml:"environment"`
+	Name        string `json:"name" yaml:"name"`
+
+	OperationStatus string `json:"operationStatus" yaml:"operationStatus"`
+	ClusterStatus   string `json:"clusterStatus" yaml:"clusterStatus"`
+
+	// LastPipelineStatus is only loaded when waiting for a deploy
+	LastPipelineStatus string `json:"lastPipelineStatus,omitempty" yaml:"lastPipelineStatus,omitempty"`
+
+	Components []ComponentStatus `json:"components" yaml:"components"`
+}
+
+func (status *Status) IsSettled() bool {
+	return !transitionalStatuses[status.OperationStatus]
+}
+
+// Equal compares the statuses, ignoring the order of the components.
+func (status *Status) Equal(other *Status) bool {
+	if other == nil || status.OperationStatus != other.OperationStatus || status.ClusterStatus != other.ClusterStatus {
+		return false
+	}
+
+	if status.LastPipelineStatus != other.LastPipelineStatus {
+		return false
+	}
+
+	if len(status.Components) != len(other.Components) {
+		return false
+	}
+
+	components := map[string]ComponentStatus{}
+	for _, item := range other.Components {
+		components[item.Name] = item
+	}
+
+	for _, item := range status.Components {
+		if components[item.Name] != item {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (status *Status) Tabulate(w *tabwriter.Writer) {
+	fmt.Fprintf(w, "%v\t %v\t %v\t %v\n", "Name", "Type", "OperationStatus", "ClusterStatus")
+	fmt.Fprintf(w, "%v\t %v\t %v\t %v\n", status.Name, "environment", status.OperationStatus, status.ClusterStatus)
+
+	for _, item := range status.Components {
+		fmt.Fprintf(w, "%v\t %v\t %v\t %v\n", item.Name, "component", item.OperationStatus, item.ClusterStatus)
+	}
+}
+
+// GetStatus fetches the operation and cluster statuses of the environment and its components.
+func GetStatus(environmentID string) (*Status, error) {
+	model, err := environment.Get(environment.NewItemOptions(environmentID))
+	if err != nil {
+		return nil, err
+	}
+
+	listOptions := component.NewListOptions()
+	listOptions.Environment = environmentID
+
+	components, err := component.ListAll(*listOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	status := &Status{
+		Environment: model.GetId(),
+		Name:        model.GetName(),
+
+		OperationStatus: model.GetOperationStatus(),
+		ClusterStatus:   model.GetClusterStatus(),
+
+		Components: make([]ComponentStatus, 0, len(components)),
+	}
+
+	for _, item := range components {
+		status.Components = append(status.Components, ComponentStatus{
+			Name: item.GetName(),
+
+			OperationStatus: item.GetOperationStatus(),
+			ClusterStatus:   item.GetClusterStatus(),
+		})
+	}
+
+	return status, nil
+}
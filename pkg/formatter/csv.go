@@ -0,0 +1,133 @@
+package formatter
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+
+	"bunnyshell.com/sdk"
+)
+
+var errCSVNotSupported = errors.New("csv output is not supported")
+
+// Recorder allows types outside of the SDK to be written as CSV, header row first.
+type Recorder interface {
+	Records() [][]string
+}
+
+func CSVFormatter(data interface{}) ([]byte, error) {
+	var records [][]string
+
+	switch dataType := data.(type) {
+	case Recorder:
+		records = dataType.Records()
+	case *sdk.PaginatedEventCollection:
+		records = [][]string{eventColumns}
+
+		if dataType.Embedded != nil {
+			for _, item := range dataType.Embedded.Item {
+				records = append(records, eventRow(item))
+			}
+		}
+	case error:
+		return JSONFormatter(data)
+	default:
+		var err error
+		if records, err = collectionRecords(data); err != nil {
+			return nil, err
+		}
+	}
+
+	var buffer bytes.Buffer
+
+	writer := csv.NewWriter(&buffer)
+	if err := writer.WriteAll(records); err != nil {
+		return nil, err
+	}
+
+	return bytes.TrimSuffix(buffer.Bytes(), []byte("\n")), nil
+}
+
+// collectionRecords writes any listing as CSV through its JSON form, one column per field, sorted by name.
+// Nested values are kept as JSON.
+func collectionRecords(data interface{}) ([][]string, error) {
+	content, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	// numbers are kept as written
+	decoder := json.NewDecoder(bytes.NewReader(content))
+	decoder.UseNumber()
+
+	var decoded interface{}
+	if err = decoder.Decode(&decoded); err != nil {
+		return nil, err
+	}
+
+	// paginated collections hold their items in _embedded
+	if object, ok := decoded.(map[string]interface{}); ok {
+		if embedded, ok := object["_embedded"].(map[string]interface{}); ok {
+			decoded = embedded["item"]
+		}
+	}
+
+	items, ok := decoded.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%w for %T, only listings are", errCSVNotSupported, data)
+	}
+
+	rows := []map[string]interface{}{}
+	columns := []string{}
+	known := map[string]bool{}
+
+	for _, item := range items {
+		row, ok := item.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("%w for %T, only listings are", errCSVNotSupported, data)
+		}
+
+		for column := range row {
+			if !known[column] {
+				known[column] = true
+				columns = append(columns, column)
+			}
+		}
+
+		rows = append(rows, row)
+	}
+
+	sort.Strings(columns)
+
+	records := [][]string{columns}
+
+	for _, row := range rows {
+		record := make([]string, len(columns))
+
+		for index, column := range columns {
+			record[index] = csvValue(row[column])
+		}
+
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+func csvValue(value interface{}) string {
+	switch value := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return value
+	case map[string]interface{}, []interface{}:
+		content, _ := json.Marshal(value)
+
+		return string(content)
+	default:
+		return fmt.Sprint(value)
+	}
+}
@@ -0,0 +1,21 @@
+package formatter
+
+import (
+	"time"
+
+	"bunnyshell.com/sdk"
+)
+
+var eventColumns = []string{"EventID", "EnvironmentID", "OrganizationID", "Type", "Status", "CreatedAt", "UpdatedAt"}
+
+func eventRow(item sdk.EventCollection) []string {
+	return []string{
+		item.GetId(),
+		item.GetEnvironment(),
+		item.GetOrganization(),
+		item.GetType(),
+		item.GetStatus(),
+		item.GetCreatedAt().Format(time.RFC3339),
+		item.GetUpdatedAt().Format(time.RFC3339),
+	}
+}
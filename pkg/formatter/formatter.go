@@ -20,6 +20,10 @@ func Formatter(data interface{}, format string) ([]byte, error) {
 		return JSONFormatter(data)
 	case "yaml", "yml":
 		return YAMLFormatter(data)
+	case "csv":
+		return CSVFormatter(data)
+	case "oneline":
+		return Oneline(data)
 	}
 
 	return nil, fmt.Errorf("%w: %s", errUnknownFormat, format)
@@ -0,0 +1,132 @@
+package formatter
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+const noGroupValue = "(none)"
+
+// GroupedStylish renders collections as one table section per distinct value of the field.
+// Data which is not a collection is rendered as usual.
+func GroupedStylish(data interface{}, field string) ([]byte, error) {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return stylish(data)
+	}
+
+	var document interface{}
+	if err = json.Unmarshal(raw, &document); err != nil {
+		return stylish(data)
+	}
+
+	items, withItems, ok := findCollectionItems(document)
+	if !ok {
+		return stylish(data)
+	}
+
+	groups := map[string][]interface{}{}
+	for _, item := range items {
+		key := groupValue(item, field)
+
+		groups[key] = append(groups[key], item)
+	}
+
+	keys := make([]string, 0, len(groups))
+	for key := range groups {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	var buffer bytes.Buffer
+
+	for index, key := range keys {
+		if index != 0 {
+			buffer.WriteString("\n")
+		}
+
+		fmt.Fprintf(&buffer, "%s: %s (%d)\n", field, key, len(groups[key]))
+
+		groupData, err := rebuild(data, withItems(groups[key]))
+		if err != nil {
+			return nil, err
+		}
+
+		result, err := stylish(groupData)
+		if err != nil {
+			return nil, err
+		}
+
+		buffer.Write(result)
+	}
+
+	return buffer.Bytes(), nil
+}
+
+func findCollectionItems(document interface{}) ([]interface{}, func([]interface{}) interface{}, bool) {
+	switch typed := document.(type) {
+	case []interface{}:
+		return typed, func(items []interface{}) interface{} {
+			return items
+		}, true
+	case map[string]interface{}:
+		embedded, ok := typed["_embedded"].(map[string]interface{})
+		if !ok {
+			return nil, nil, false
+		}
+
+		items, ok := embedded["item"].([]interface{})
+		if !ok {
+			return nil, nil, false
+		}
+
+		return items, func(items []interface{}) interface{} {
+			embedded["item"] = items
+
+			return typed
+		}, true
+	}
+
+	return nil, nil, false
+}
+
+func groupValue(item interface{}, field string) string {
+	fields, ok := item.(map[string]interface{})
+	if !ok {
+		return noGroupValue
+	}
+
+	for key, value := range fields {
+		if !strings.EqualFold(key, field) {
+			continue
+		}
+
+		if value == nil || value == "" {
+			return noGroupValue
+		}
+
+		return fmt.Sprint(value)
+	}
+
+	return noGroupValue
+}
+
+// rebuild decodes the document back into the original data type, so the regular table renderers apply.
+func rebuild(data interface{}, document interface{}) (interface{}, error) {
+	raw, err := json.Marshal(document)
+	if err != nil {
+		return nil, err
+	}
+
+	value := reflect.New(reflect.TypeOf(data))
+	if err = json.Unmarshal(raw, value.Interface()); err != nil {
+		return nil, err
+	}
+
+	return value.Elem().Interface(), nil
+}
@@ -0,0 +1,125 @@
+package formatter
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"bunnyshell.com/sdk"
+)
+
+const onelineEmpty = "-"
+
+var errOnelineNotSupported = errors.New("oneline output is not supported")
+
+// Oneliner allows types outside of the SDK to provide their oneline rows.
+type Oneliner interface {
+	OnelineRows() [][]string
+}
+
+// Oneline prints "ID NAME STATUS UPDATED" per resource.
+// The column order is relied upon by scripts and must not change.
+func Oneline(data interface{}) ([]byte, error) {
+	lines := [][]string{}
+
+	switch dataType := data.(type) {
+	case *sdk.PaginatedEnvironmentCollection:
+		if dataType.Embedded != nil {
+			lines = onelineEnvironments(dataType.Embedded.Item)
+		}
+	case []sdk.EnvironmentCollection:
+		lines = onelineEnvironments(dataType)
+	case *sdk.PaginatedComponentCollection:
+		if dataType.Embedded != nil {
+			lines = onelineComponents(dataType.Embedded.Item)
+		}
+	case []sdk.ComponentCollection:
+		lines = onelineComponents(dataType)
+	case *sdk.PaginatedEventCollection:
+		if dataType.Embedded != nil {
+			lines = onelineEvents(dataType.Embedded.Item)
+		}
+	case Oneliner:
+		lines = dataType.OnelineRows()
+	case error:
+		return stylish(data)
+	default:
+		return nil, fmt.Errorf("%w for %T", errOnelineNotSupported, data)
+	}
+
+	var buffer bytes.Buffer
+
+	for index, line := range lines {
+		if index != 0 {
+			buffer.WriteString("\n")
+		}
+
+		buffer.WriteString(strings.Join(onelineFields(line), " "))
+	}
+
+	return buffer.Bytes(), nil
+}
+
+func onelineEnvironments(items []sdk.EnvironmentCollection) [][]string {
+	lines := [][]string{}
+
+	for index := range items {
+		item := &items[index]
+
+		lines = append(lines, []string{item.GetId(), item.GetName(), item.GetOperationStatus(), onelineUpdated(item)})
+	}
+
+	return lines
+}
+
+func onelineComponents(items []sdk.ComponentCollection) [][]string {
+	lines := [][]string{}
+
+	for index := range items {
+		item := &items[index]
+
+		lines = append(lines, []string{item.GetId(), item.GetName(), item.GetOperationStatus(), onelineUpdated(item)})
+	}
+
+	return lines
+}
+
+func onelineEvents(items []sdk.EventCollection) [][]string {
+	lines := [][]string{}
+
+	for index := range items {
+		item := &items[index]
+
+		lines = append(lines, []string{item.GetId(), item.GetType(), item.GetStatus(), onelineUpdated(item)})
+	}
+
+	return lines
+}
+
+// onelineUpdated uses the update time when the model provides one.
+func onelineUpdated(item interface{}) string {
+	updated, ok := item.(interface{ GetUpdatedAt() time.Time })
+	if !ok || updated.GetUpdatedAt().IsZero() {
+		return onelineEmpty
+	}
+
+	return updated.GetUpdatedAt().UTC().Format(time.RFC3339)
+}
+
+// onelineFields keeps the column count stable, whitespace would break field splitting.
+func onelineFields(line []string) []string {
+	fields := make([]string, len(line))
+
+	for index, field := range line {
+		field = strings.Join(strings.Fields(field), "_")
+		if field == "" {
+			field = onelineEmpty
+		}
+
+		fields[index] = field
+	}
+
+	return fields
+}
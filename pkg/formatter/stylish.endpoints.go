@@ -30,3 +30,22 @@ func tabulateAggregateEndpoint(writer *tabwriter.Writer, components []sdk.Compon
 		}
 	}
 }
+
+func tabulateComponentEndpointItem(writer *tabwriter.Writer, item *sdk.ComponentEndpointItem) {
+	fmt.Fprintf(writer, "%v\t %v\n", "Name", item.GetName())
+
+	endpoints := item.GetEndpoints()
+	if len(endpoints) == 0 {
+		fmt.Fprintf(writer, "%v\t %v\n", "Endpoints", "none")
+
+		return
+	}
+
+	for index, endpoint := range endpoints {
+		if index == 0 {
+			fmt.Fprintf(writer, "%v\t %v\n", "Endpoints", endpoint)
+		} else {
+			fmt.Fprintf(writer, "\t %v\n", endpoint)
+		}
+	}
+}
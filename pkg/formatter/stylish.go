@@ -3,12 +3,18 @@ package formatter
 import (
 	"bytes"
 	"fmt"
+	"strings"
 	"text/tabwriter"
 
 	"bunnyshell.com/cli/pkg/api"
 	"bunnyshell.com/sdk"
 )
 
+// Tabulator allows types outside of the SDK to render their own tables.
+type Tabulator interface {
+	Tabulate(writer *tabwriter.Writer)
+}
+
 func stylish(data interface{}) ([]byte, error) {
 	var (
 		buffer bytes.Buffer
@@ -24,8 +30,12 @@ func stylish(data interface{}) ([]byte, error) {
 		tabulateProjectCollection(writer, dataType)
 	case *sdk.PaginatedEnvironmentCollection:
 		tabulateEnvironmentCollection(writer, dataType)
+	case []sdk.EnvironmentCollection:
+		tabulateEnvironmentList(writer, dataType)
 	case *sdk.PaginatedComponentCollection:
 		tabulateComponentCollection(writer, dataType)
+	case []sdk.ComponentCollection:
+		tabulateComponentList(writer, dataType)
 	case *sdk.PaginatedEventCollection:
 		tabulateEventCollection(writer, dataType)
 	case *sdk.PaginatedEnvironmentVariableCollection:
@@ -48,6 +58,8 @@ func stylish(data interface{}) ([]byte, error) {
 		tabulateRegistryIntegrationsCollection(writer, dataType)
 	case *sdk.PaginatedServiceComponentVariableCollection:
 		tabulateServiceComponentVariableCollection(writer, dataType)
+	case *sdk.ComponentEndpointItem:
+		tabulateComponentEndpointItem(writer, dataType)
 	case []sdk.ComponentEndpointCollection:
 		tabulateAggregateEndpoint(writer, dataType)
 	case *sdk.OrganizationItem:
@@ -56,6 +68,8 @@ func stylish(data interface{}) ([]byte, error) {
 		tabulateProjectItem(writer, dataType)
 	case *sdk.EnvironmentItem:
 		tabulateEnvironmentItem(writer, dataType)
+	case *sdk.BuildSettingsItem:
+		tabulateBuildSettingsItem(writer, dataType)
 	case *sdk.ComponentItem:
 		tabulateComponentItem(writer, dataType)
 	case *sdk.EventItem:
@@ -90,6 +104,8 @@ func stylish(data interface{}) ([]byte, error) {
 		tabulateAPIError(writer, &dataType)
 	case error:
 		tabulateError(writer, dataType)
+	case Tabulator:
+		dataType.Tabulate(writer)
 	default:
 		err = writeJSON(writer, data)
 	}
@@ -99,6 +115,18 @@ func stylish(data interface{}) ([]byte, error) {
 	return buffer.Bytes(), err
 }
 
+// WriteStylish renders data as a section of a Tabulator's table.
+func WriteStylish(w *tabwriter.Writer, data interface{}) {
+	result, err := stylish(data)
+	if err != nil {
+		fmt.Fprintln(w, err)
+
+		return
+	}
+
+	fmt.Fprint(w, string(result))
+}
+
 func tabulateOrganizationCollection(w *tabwriter.Writer, data *sdk.PaginatedOrganizationCollection) {
 	fmt.Fprintf(w, "%v\t %v\t %v\n", "OrganizationID", "Name", "Timezone")
 
@@ -179,6 +207,18 @@ func tabulateBuildSettings(w *tabwriter.Writer, item *sdk.BuildSettingsItem) {
 	}
 }
 
+func tabulateBuildSettingsItem(w *tabwriter.Writer, item *sdk.BuildSettingsItem) {
+	tabulateBuildSettings(w, item)
+
+	if cpu, ok := item.GetCpuOk(); ok && cpu != nil {
+		fmt.Fprintf(w, "%v\t %v\n", "Build CPU", *cpu)
+	}
+
+	if memory, ok := item.GetMemoryOk(); ok && memory != nil {
+		fmt.Fprintf(w, "%v\t %v\n", "Build Memory", *memory)
+	}
+}
+
 func tabulateEnvironmentCollection(w *tabwriter.Writer, data *sdk.PaginatedEnvironmentCollection) {
 	fmt.Fprintf(w, "%v\t %v\t %v\t %v\t %v\t %v\n", "EnvironmentID", "ProjectID", "Name", "Namespace", "Type", "OperationStatus")
 
@@ -189,6 +229,14 @@ func tabulateEnvironmentCollection(w *tabwriter.Writer, data *sdk.PaginatedEnvir
 	}
 }
 
+func tabulateEnvironmentList(w *tabwriter.Writer, data []sdk.EnvironmentCollection) {
+	fmt.Fprintf(w, "%v\t %v\t %v\t %v\t %v\t %v\n", "EnvironmentID", "ProjectID", "Name", "Namespace", "Type", "OperationStatus")
+
+	for _, item := range data {
+		fmt.Fprintf(w, "%v\t %v\t %v\t %v\t %v\t %v\n", item.GetId(), item.GetProject(), item.GetName(), item.GetNamespace(), item.GetType(), item.GetOperationStatus())
+	}
+}
+
 func tabulateEnvironmentItem(w *tabwriter.Writer, item *sdk.EnvironmentItem) {
 	fmt.Fprintf(w, "%v\t %v\n", "EnvironmentID", item.GetId())
 	fmt.Fprintf(w, "%v\t %v\n", "ProjectID", item.GetProject())
@@ -237,6 +285,14 @@ func tabulateComponentCollection(w *tabwriter.Writer, data *sdk.PaginatedCompone
 	}
 }
 
+func tabulateComponentList(w *tabwriter.Writer, data []sdk.ComponentCollection) {
+	fmt.Fprintf(w, "%v\t %v\t %v\t %v\t %v\n", "ComponentID", "EnvironmentID", "Name", "OperationStatus", "ClusterStatus")
+
+	for _, item := range data {
+		fmt.Fprintf(w, "%v\t %v\t %v\t %v\t %v\n", item.GetId(), item.GetEnvironment(), item.GetName(), item.GetOperationStatus(), item.GetClusterStatus())
+	}
+}
+
 func tabulateComponentItem(w *tabwriter.Writer, item *sdk.ComponentItem) {
 	fmt.Fprintf(w, "%v\t %v\n", "ComponentID", item.GetId())
 	fmt.Fprintf(w, "%v\t %v\n", "EnvironmentID", item.GetEnvironment())
@@ -294,12 +350,19 @@ func tabulateProjectVariableCollection(w *tabwriter.Writer, data *sdk.PaginatedP
 }
 
 func tabulateEventCollection(w *tabwriter.Writer, data *sdk.PaginatedEventCollection) {
-	fmt.Fprintf(w, "%v\t %v\t %v\t %v\t %v\n", "EventID", "EnvironmentID", "OrganizationID", "Type", "Status")
-
+	items := []sdk.EventCollection{}
 	if data.Embedded != nil {
-		for _, item := range data.Embedded.Item {
-			fmt.Fprintf(w, "%v\t %v\t %v\t %v\t %v\n", item.GetId(), item.GetEnvironment(), item.GetOrganization(), item.GetType(), item.GetStatus())
-		}
+		items = data.Embedded.Item
+	}
+
+	tabulateEventList(w, items)
+}
+
+func tabulateEventList(w *tabwriter.Writer, items []sdk.EventCollection) {
+	fmt.Fprintln(w, strings.Join(eventColumns, "\t "))
+
+	for _, item := range items {
+		fmt.Fprintln(w, strings.Join(eventRow(item), "\t "))
 	}
 }
 
@@ -0,0 +1,130 @@
+package git
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	defaultRemote = "origin"
+
+	definitionFileName = "bunnyshell.yaml"
+)
+
+var (
+	errDetachedHead = errors.New("the current repository is in a detached HEAD state, checkout a branch first")
+	errNoRemote     = errors.New("the current branch has no remote repository")
+)
+
+type LocalRepository struct {
+	URL    string
+	Branch string
+
+	// YamlPath is the environment definition, relative to the repository root
+	YamlPath string
+}
+
+// DetectLocalRepository reads the remote, branch and definition path of the git checkout containing dir.
+func DetectLocalRepository(dir string) (*LocalRepository, error) {
+	root, err := runGit(dir, "rev-parse", "--show-toplevel")
+	if err != nil {
+		return nil, fmt.Errorf("not inside a git repository: %w", err)
+	}
+
+	// fails on a detached HEAD, unlike rev-parse it also works before the first commit
+	branch, err := runGit(dir, "symbolic-ref", "--short", "-q", "HEAD")
+	if err != nil || branch == "" {
+		return nil, errDetachedHead
+	}
+
+	// the branch tracks its own remote, falling back to the conventional one
+	remote, err := runGit(dir, "config", "branch."+branch+".remote")
+	if err != nil || remote == "" {
+		remote = defaultRemote
+	}
+
+	remoteURL, err := runGit(dir, "remote", "get-url", remote)
+	if err != nil || remoteURL == "" {
+		return nil, fmt.Errorf("%w: %s", errNoRemote, branch)
+	}
+
+	return &LocalRepository{
+		URL:    NormalizeRemoteURL(remoteURL),
+		Branch: branch,
+
+		YamlPath: getDefinitionPath(root, dir),
+	}, nil
+}
+
+// NormalizeRemoteURL turns scp-like remotes into https URLs and drops any credentials.
+func NormalizeRemoteURL(remote string) string {
+	if !strings.Contains(remote, "://") {
+		if host, path, found := strings.Cut(remote, ":"); found {
+			if _, hostName, hasUser := strings.Cut(host, "@"); hasUser {
+				host = hostName
+			}
+
+			return "https://" + host + "/" + strings.TrimPrefix(path, "/")
+		}
+	}
+
+	info, err := url.Parse(remote)
+	if err != nil {
+		return remote
+	}
+
+	if info.Scheme == "ssh" || info.Scheme == "git" {
+		info.Scheme = "https"
+		info.Host = info.Hostname()
+	}
+
+	info.User = nil
+
+	return info.String()
+}
+
+// getDefinitionPath prefers the definition of the working directory when one exists, else the one at the root.
+func getDefinitionPath(root string, dir string) string {
+	absDir, err := filepath.Abs(dir)
+	if err != nil {
+		return "/" + definitionFileName
+	}
+
+	if _, err := os.Stat(filepath.Join(absDir, definitionFileName)); err != nil {
+		return "/" + definitionFileName
+	}
+
+	// git reports the root with resolved symlinks
+	if resolved, err := filepath.EvalSymlinks(absDir); err == nil {
+		absDir = resolved
+	}
+
+	relative, err := filepath.Rel(root, filepath.Join(absDir, definitionFileName))
+	if err != nil || strings.HasPrefix(relative, "..") {
+		return "/" + definitionFileName
+	}
+
+	return "/" + filepath.ToSlash(relative)
+}
+
+func runGit(dir string, args ...string) (string, error) {
+	command := exec.Command("git", args...)
+	command.Dir = dir
+
+	output, err := command.Output()
+	if err != nil {
+		var exitError *exec.ExitError
+		if errors.As(err, &exitError) && len(exitError.Stderr) > 0 {
+			return "", errors.New(strings.TrimSpace(string(exitError.Stderr)))
+		}
+
+		return "", err
+	}
+
+	return strings.TrimSpace(string(output)), nil
+}
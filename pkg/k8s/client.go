@@ -27,6 +27,9 @@ const (
 	DeploymentKind  = "deployment"
 	StatefulSetKind = "statefulset"
 	DaemonSetKind   = "daemonset"
+	JobKind         = "job"
+	CronJobKind     = "cronjob"
+	PodKind         = "pod"
 )
 
 type KubernetesClient struct {
@@ -38,13 +41,24 @@ type KubernetesClient struct {
 }
 
 func NewKubernetesClient(kubeConfigPath string) (*KubernetesClient, error) {
-	newKubernetes := new(KubernetesClient)
-
 	kubeconfig, err := os.ReadFile(kubeConfigPath)
+	if err != nil {
+		return new(KubernetesClient), err
+	}
+
+	newKubernetes, err := NewKubernetesClientFromBytes(kubeconfig)
 	if err != nil {
 		return newKubernetes, err
 	}
 
+	newKubernetes.kubeConfigPath = kubeConfigPath
+
+	return newKubernetes, nil
+}
+
+func NewKubernetesClientFromBytes(kubeconfig []byte) (*KubernetesClient, error) {
+	newKubernetes := new(KubernetesClient)
+
 	config, err := clientcmd.NewClientConfigFromBytes(kubeconfig)
 	if err != nil {
 		return newKubernetes, err
@@ -60,7 +74,6 @@ func NewKubernetesClient(kubeConfigPath string) (*KubernetesClient, error) {
 		return newKubernetes, err
 	}
 
-	newKubernetes.kubeConfigPath = kubeConfigPath
 	newKubernetes.config = config
 	newKubernetes.restConfig = restConfig
 	newKubernetes.clientSet = clientset
@@ -0,0 +1,96 @@
+package k8s
+
+import (
+	"sort"
+
+	"bunnyshell.com/cli/pkg/util"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdApi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+// DefaultKubeConfigPath honors $KUBECONFIG, falling back to ~/.kube/config.
+func DefaultKubeConfigPath() string {
+	return clientcmd.NewDefaultPathOptions().GetDefaultFilename()
+}
+
+// MergeKubeConfig adds the clusters, users and contexts of kubeConfig into the file at path,
+// replacing the entries with the same name, and returns the merged context names.
+func MergeKubeConfig(path string, kubeConfig []byte, setCurrentContext bool) ([]string, error) {
+	incoming, err := clientcmd.Load(kubeConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	existing := clientcmdApi.NewConfig()
+
+	exists, err := util.FileExists(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if exists {
+		if existing, err = clientcmd.LoadFromFile(path); err != nil {
+			return nil, err
+		}
+	}
+
+	for name, cluster := range incoming.Clusters {
+		existing.Clusters[name] = cluster
+	}
+
+	for name, authInfo := range incoming.AuthInfos {
+		existing.AuthInfos[name] = authInfo
+	}
+
+	contexts := make([]string, 0, len(incoming.Contexts))
+
+	for name, context := range incoming.Contexts {
+		existing.Contexts[name] = context
+
+		contexts = append(contexts, name)
+	}
+
+	sort.Strings(contexts)
+
+	if setCurrentContext && incoming.CurrentContext != "" {
+		existing.CurrentContext = incoming.CurrentContext
+	}
+
+	return contexts, clientcmd.WriteToFile(*existing, path)
+}
+
+// CurrentContextServer returns the current context of the kubeconfig and the server of its cluster.
+func CurrentContextServer(kubeConfig []byte) (string, string, error) {
+	config, err := clientcmd.Load(kubeConfig)
+	if err != nil {
+		return "", "", err
+	}
+
+	context, ok := config.Contexts[config.CurrentContext]
+	if !ok {
+		return config.CurrentContext, "", nil
+	}
+
+	cluster, ok := config.Clusters[context.Cluster]
+	if !ok {
+		return config.CurrentContext, "", nil
+	}
+
+	return config.CurrentContext, cluster.Server, nil
+}
+
+// WithNamespace sets the namespace of the kubeconfig contexts which have none, so kubectl defaults to it.
+func WithNamespace(kubeConfig []byte, namespace string) ([]byte, error) {
+	config, err := clientcmd.Load(kubeConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, context := range config.Contexts {
+		if context.Namespace == "" {
+			context.Namespace = namespace
+		}
+	}
+
+	return clientcmd.Write(*config)
+}
@@ -0,0 +1,108 @@
+package cp
+
+import (
+	"os"
+	"strings"
+
+	"bunnyshell.com/cli/pkg/build"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/restmapper"
+	"k8s.io/client-go/tools/clientcmd"
+	k8sCp "k8s.io/kubectl/pkg/cmd/cp"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
+)
+
+// ContainerPathPrefix marks the container side of a copy, as in ":/path/in/container".
+const ContainerPathPrefix = ":"
+
+type Options struct {
+	Namespace string
+	PodName   string
+
+	Source      string
+	Destination string
+
+	NoPreserve bool
+
+	KubeConfig []byte
+}
+
+func Copy(options *Options) (*k8sCp.CopyOptions, error) {
+	config, err := clientcmd.NewClientConfigFromBytes(options.KubeConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	copyOptions := k8sCp.NewCopyOptions(genericclioptions.IOStreams{In: os.Stdin, Out: os.Stdout, ErrOut: os.Stderr})
+	copyOptions.NoPreserve = options.NoPreserve
+
+	args := []string{
+		getFileSpec(options.Source, options.Namespace, options.PodName),
+		getFileSpec(options.Destination, options.Namespace, options.PodName),
+	}
+
+	factory := cmdutil.NewFactory(&restClientGetter{config: config})
+	if err = copyOptions.Complete(factory, &cobra.Command{}, args); err != nil {
+		return nil, err
+	}
+
+	return copyOptions, nil
+}
+
+// getFileSpec expands container paths to the namespace/pod:path format kubectl expects
+func getFileSpec(path string, namespace string, podName string) string {
+	if !strings.HasPrefix(path, ContainerPathPrefix) {
+		return path
+	}
+
+	return namespace + "/" + podName + path
+}
+
+type restClientGetter struct {
+	config clientcmd.ClientConfig
+}
+
+func (g *restClientGetter) ToRESTConfig() (*rest.Config, error) {
+	restConfig, err := g.config.ClientConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	if restConfig.UserAgent == "" {
+		restConfig.UserAgent = "BunnyCLI+" + build.Version
+	}
+
+	return restConfig, nil
+}
+
+func (g *restClientGetter) ToDiscoveryClient() (discovery.CachedDiscoveryInterface, error) {
+	restConfig, err := g.ToRESTConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := discovery.NewDiscoveryClientForConfig(restConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return memory.NewMemCacheClient(client), nil
+}
+
+func (g *restClientGetter) ToRESTMapper() (meta.RESTMapper, error) {
+	client, err := g.ToDiscoveryClient()
+	if err != nil {
+		return nil, err
+	}
+
+	return restmapper.NewDeferredDiscoveryRESTMapper(client), nil
+}
+
+func (g *restClientGetter) ToRawKubeConfigLoader() clientcmd.ClientConfig {
+	return g.config
+}
@@ -4,6 +4,7 @@ import (
 	"os"
 
 	"bunnyshell.com/cli/pkg/build"
+	"golang.org/x/term"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 	"k8s.io/client-go/kubernetes"
@@ -27,7 +28,8 @@ func Exec(options *Options) (*k8sExec.ExecOptions, error) {
 		return nil, err
 	}
 
-	execOptions.TTY = options.TTY
+	// a TTY only makes sense when attached to a terminal, piped input or output would be mangled
+	execOptions.TTY = options.TTY && term.IsTerminal(int(os.Stdin.Fd())) && term.IsTerminal(int(os.Stdout.Fd()))
 	execOptions.Stdin = options.Stdin
 	execOptions.Command = options.Command
 
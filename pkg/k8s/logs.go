@@ -0,0 +1,44 @@
+package k8s
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"io"
+
+	coreV1 "k8s.io/api/core/v1"
+)
+
+// log lines longer than this are split
+const maxLogLineSize = 1024 * 1024
+
+// StreamLogs passes each log line of the pod container to handleLine, until the logs end or ctx is cancelled.
+func (k *KubernetesClient) StreamLogs(
+	ctx context.Context,
+	pod *coreV1.Pod,
+	logOptions *coreV1.PodLogOptions,
+	handleLine func(line string),
+) error {
+	stream, err := k.clientSet.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, logOptions).Stream(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer stream.Close()
+
+	reader := bufio.NewReaderSize(stream, maxLogLineSize)
+
+	for {
+		// a line longer than the buffer comes in several parts, each handled as a line
+		line, _, err := reader.ReadLine()
+		if err != nil {
+			if errors.Is(err, io.EOF) || ctx.Err() != nil {
+				return nil
+			}
+
+			return err
+		}
+
+		handleLine(string(line))
+	}
+}
@@ -0,0 +1,49 @@
+package k8s
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	coreV1 "k8s.io/api/core/v1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+const podMetricsPath = "/apis/metrics.k8s.io/v1beta1"
+
+var ErrMetricsUnavailable = errors.New("no metrics available, the cluster may lack a metrics server")
+
+// the subset of metrics.k8s.io PodMetrics in use, the client is not worth the dependency
+type podMetrics struct {
+	Containers []struct {
+		Name  string              `json:"name"`
+		Usage coreV1.ResourceList `json:"usage"`
+	} `json:"containers"`
+}
+
+// GetPodUsage returns the current resource usage of each pod container, as reported by the metrics server.
+func (k *KubernetesClient) GetPodUsage(namespace, name string) (map[string]coreV1.ResourceList, error) {
+	body, err := k.clientSet.CoreV1().RESTClient().Get().
+		AbsPath(podMetricsPath, "namespaces", namespace, "pods", name).
+		DoRaw(context.TODO())
+	if apiErrors.IsNotFound(err) {
+		return nil, fmt.Errorf("%w: pod %s/%s", ErrMetricsUnavailable, namespace, name)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	metrics := podMetrics{}
+	if err = json.Unmarshal(body, &metrics); err != nil {
+		return nil, err
+	}
+
+	usage := map[string]coreV1.ResourceList{}
+	for _, container := range metrics.Containers {
+		usage[container.Name] = container.Usage
+	}
+
+	return usage, nil
+}
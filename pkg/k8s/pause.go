@@ -0,0 +1,51 @@
+package k8s
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// the replicas to restore on resume, the workload is paused while set
+const pausedReplicasAnnotation = "bunnyshell.com/paused-replicas"
+
+// Pause scales the workload to zero, remembering its replicas for Resume. Pausing twice keeps the first replicas.
+func (k *KubernetesClient) Pause(namespace, kind, name string) error {
+	workload, err := k.GetWorkload(namespace, kind, name)
+	if err != nil {
+		return err
+	}
+
+	if _, paused := workload.Annotations[pausedReplicasAnnotation]; paused {
+		return nil
+	}
+
+	return k.patchScalable(namespace, kind, name, []byte(fmt.Sprintf(
+		`{"metadata":{"annotations":{%q:"%d"}},"spec":{"replicas":0}}`,
+		pausedReplicasAnnotation,
+		workload.Replicas,
+	)))
+}
+
+// Resume restores the replicas the workload had when paused, reporting whether it was paused at all.
+func (k *KubernetesClient) Resume(namespace, kind, name string) (bool, error) {
+	workload, err := k.GetWorkload(namespace, kind, name)
+	if err != nil {
+		return false, err
+	}
+
+	value, paused := workload.Annotations[pausedReplicasAnnotation]
+	if !paused {
+		return false, nil
+	}
+
+	replicas, err := strconv.ParseInt(value, 10, 32)
+	if err != nil {
+		return false, fmt.Errorf("invalid %s annotation: %w", pausedReplicasAnnotation, err)
+	}
+
+	return true, k.patchScalable(namespace, kind, name, []byte(fmt.Sprintf(
+		`{"metadata":{"annotations":{%q:null}},"spec":{"replicas":%d}}`,
+		pausedReplicasAnnotation,
+		replicas,
+	)))
+}
@@ -0,0 +1,144 @@
+package k8s
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	batchV1 "k8s.io/api/batch/v1"
+	coreV1 "k8s.io/api/core/v1"
+	apiMetaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var ErrNoRunningPod = errors.New("no running pod found")
+
+func (k *KubernetesClient) GetJob(namespace, name string) (*batchV1.Job, error) {
+	return k.clientSet.BatchV1().Jobs(namespace).Get(context.TODO(), name, apiMetaV1.GetOptions{})
+}
+
+func (k *KubernetesClient) ListJobs(namespace string, listOptions apiMetaV1.ListOptions) (*batchV1.JobList, error) {
+	return k.clientSet.BatchV1().Jobs(namespace).List(context.TODO(), listOptions)
+}
+
+func (k *KubernetesClient) GetCronJob(namespace, name string) (*batchV1.CronJob, error) {
+	return k.clientSet.BatchV1().CronJobs(namespace).Get(context.TODO(), name, apiMetaV1.GetOptions{})
+}
+
+// ResolveRunningPod finds a running pod belonging to the namespace/kind/name resource.
+func (k *KubernetesClient) ResolveRunningPod(namespace, kind, name string) (*coreV1.Pod, error) {
+	pods, err := k.ResourcePods(namespace, kind, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if strings.ToLower(kind) == PodKind && !isPodRunning(pods[0]) {
+		return nil, fmt.Errorf("%w: pod %s/%s is %s", ErrNoRunningPod, namespace, name, pods[0].Status.Phase)
+	}
+
+	return pickRunningPod(pods, kind, name)
+}
+
+// ResourcePods lists the pods belonging to the namespace/kind/name resource, whatever their phase.
+func (k *KubernetesClient) ResourcePods(namespace, kind, name string) ([]coreV1.Pod, error) {
+	switch strings.ToLower(kind) {
+	case PodKind:
+		pod, err := k.GetPod(namespace, name)
+		if err != nil {
+			return nil, err
+		}
+
+		return []coreV1.Pod{*pod}, nil
+	case DeploymentKind, StatefulSetKind, DaemonSetKind:
+		pods, err := k.WorkflowPodsList(namespace, kind, name)
+		if err != nil {
+			return nil, err
+		}
+
+		return pods.Items, nil
+	case JobKind:
+		job, err := k.GetJob(namespace, name)
+		if err != nil {
+			return nil, err
+		}
+
+		return k.jobPods(job)
+	case CronJobKind:
+		return k.cronJobPods(namespace, name)
+	default:
+		return nil, fmt.Errorf("unsupported '%s' resource kind", kind)
+	}
+}
+
+func (k *KubernetesClient) jobPods(job *batchV1.Job) ([]coreV1.Pod, error) {
+	selector, err := apiMetaV1.LabelSelectorAsSelector(job.Spec.Selector)
+	if err != nil {
+		return nil, err
+	}
+
+	podsList, err := k.ListPods(job.Namespace, apiMetaV1.ListOptions{
+		LabelSelector: selector.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return podsList.Items, nil
+}
+
+// cronJobPods returns the pods of the jobs spawned by the cronjob, most recent jobs first.
+func (k *KubernetesClient) cronJobPods(namespace, name string) ([]coreV1.Pod, error) {
+	cronJob, err := k.GetCronJob(namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	jobsList, err := k.ListJobs(namespace, apiMetaV1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	jobs := []batchV1.Job{}
+
+	for _, job := range jobsList.Items {
+		for _, owner := range job.OwnerReferences {
+			if owner.UID == cronJob.UID {
+				jobs = append(jobs, job)
+
+				break
+			}
+		}
+	}
+
+	sort.Slice(jobs, func(i, j int) bool {
+		return jobs[j].CreationTimestamp.Before(&jobs[i].CreationTimestamp)
+	})
+
+	pods := []coreV1.Pod{}
+
+	for index := range jobs {
+		jobPods, err := k.jobPods(&jobs[index])
+		if err != nil {
+			return nil, err
+		}
+
+		pods = append(pods, jobPods...)
+	}
+
+	return pods, nil
+}
+
+func pickRunningPod(pods []coreV1.Pod, kind, name string) (*coreV1.Pod, error) {
+	for index := range pods {
+		if isPodRunning(pods[index]) {
+			return &pods[index], nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w for %s %s", ErrNoRunningPod, kind, name)
+}
+
+func isPodRunning(pod coreV1.Pod) bool {
+	return pod.Status.Phase == coreV1.PodRunning && pod.DeletionTimestamp == nil
+}
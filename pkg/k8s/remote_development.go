@@ -0,0 +1,59 @@
+package k8s
+
+import (
+	"fmt"
+	"strings"
+
+	"bunnyshell.com/dev/pkg/remote"
+	apiMetaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// HasRemoteDevSession checks if the workload was patched for remote development.
+func (k *KubernetesClient) HasRemoteDevSession(namespace, kind, name string) (bool, error) {
+	var objectMeta, templateMeta apiMetaV1.ObjectMeta
+
+	switch strings.ToLower(kind) {
+	case DeploymentKind:
+		deployment, err := k.GetDeployment(namespace, name)
+		if err != nil {
+			return false, err
+		}
+
+		objectMeta, templateMeta = deployment.ObjectMeta, deployment.Spec.Template.ObjectMeta
+	case StatefulSetKind:
+		statefulset, err := k.GetStatefulSet(namespace, name)
+		if err != nil {
+			return false, err
+		}
+
+		objectMeta, templateMeta = statefulset.ObjectMeta, statefulset.Spec.Template.ObjectMeta
+	case DaemonSetKind:
+		daemonset, err := k.GetDaemonSet(namespace, name)
+		if err != nil {
+			return false, err
+		}
+
+		objectMeta, templateMeta = daemonset.ObjectMeta, daemonset.Spec.Template.ObjectMeta
+	default:
+		return false, fmt.Errorf("unsupported '%s' resource kind", kind)
+	}
+
+	return hasRemoteDevMarker(objectMeta) || hasRemoteDevMarker(templateMeta), nil
+}
+
+// hasRemoteDevMarker looks for the metadata the remote development library sets on the patched workloads.
+func hasRemoteDevMarker(meta apiMetaV1.ObjectMeta) bool {
+	for key := range meta.GetLabels() {
+		if strings.HasPrefix(key, remote.MetadataPrefix) {
+			return true
+		}
+	}
+
+	for key := range meta.GetAnnotations() {
+		if strings.HasPrefix(key, remote.MetadataPrefix) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,120 @@
+package k8s
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	apiMetaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const restartedAtAnnotation = "kubectl.kubernetes.io/restartedAt"
+
+var (
+	ErrRolloutUnsupported = errors.New("only deployments, statefulsets and daemonsets can be restarted")
+	ErrScaleUnsupported   = errors.New("only deployments and statefulsets can be scaled")
+)
+
+// RolloutRestart replaces the pods of the workload, the same way "kubectl rollout restart" does.
+func (k *KubernetesClient) RolloutRestart(namespace, kind, name string) error {
+	patch := []byte(fmt.Sprintf(
+		`{"spec":{"template":{"metadata":{"annotations":{%q:%q}}}}}`,
+		restartedAtAnnotation,
+		time.Now().Format(time.RFC3339),
+	))
+
+	apps := k.clientSet.AppsV1()
+
+	var err error
+
+	switch strings.ToLower(kind) {
+	case DeploymentKind:
+		_, err = apps.Deployments(namespace).Patch(context.TODO(), name, types.StrategicMergePatchType, patch, apiMetaV1.PatchOptions{})
+	case StatefulSetKind:
+		_, err = apps.StatefulSets(namespace).Patch(context.TODO(), name, types.StrategicMergePatchType, patch, apiMetaV1.PatchOptions{})
+	case DaemonSetKind:
+		_, err = apps.DaemonSets(namespace).Patch(context.TODO(), name, types.StrategicMergePatchType, patch, apiMetaV1.PatchOptions{})
+	default:
+		return fmt.Errorf("%w: %s", ErrRolloutUnsupported, kind)
+	}
+
+	return err
+}
+
+func (k *KubernetesClient) Scale(namespace, kind, name string, replicas int32) error {
+	return k.patchScalable(namespace, kind, name, []byte(fmt.Sprintf(`{"spec":{"replicas":%d}}`, replicas)))
+}
+
+func (k *KubernetesClient) patchScalable(namespace, kind, name string, patch []byte) error {
+	apps := k.clientSet.AppsV1()
+
+	var err error
+
+	switch strings.ToLower(kind) {
+	case DeploymentKind:
+		_, err = apps.Deployments(namespace).Patch(context.TODO(), name, types.MergePatchType, patch, apiMetaV1.PatchOptions{})
+	case StatefulSetKind:
+		_, err = apps.StatefulSets(namespace).Patch(context.TODO(), name, types.MergePatchType, patch, apiMetaV1.PatchOptions{})
+	default:
+		return fmt.Errorf("%w: %s", ErrScaleUnsupported, kind)
+	}
+
+	return err
+}
+
+// IsRolloutComplete tells whether all the workload pods run the latest template and are available.
+func (k *KubernetesClient) IsRolloutComplete(namespace, kind, name string) (bool, error) {
+	switch strings.ToLower(kind) {
+	case DeploymentKind:
+		deployment, err := k.GetDeployment(namespace, name)
+		if err != nil {
+			return false, err
+		}
+
+		replicas := replicasOrDefault(deployment.Spec.Replicas)
+		status := deployment.Status
+
+		return status.ObservedGeneration >= deployment.Generation &&
+			status.UpdatedReplicas == replicas &&
+			status.Replicas == replicas &&
+			status.AvailableReplicas == replicas, nil
+	case StatefulSetKind:
+		statefulset, err := k.GetStatefulSet(namespace, name)
+		if err != nil {
+			return false, err
+		}
+
+		replicas := replicasOrDefault(statefulset.Spec.Replicas)
+		status := statefulset.Status
+
+		return status.ObservedGeneration >= statefulset.Generation &&
+			status.UpdatedReplicas == replicas &&
+			status.ReadyReplicas == replicas &&
+			status.CurrentRevision == status.UpdateRevision, nil
+	case DaemonSetKind:
+		daemonset, err := k.GetDaemonSet(namespace, name)
+		if err != nil {
+			return false, err
+		}
+
+		status := daemonset.Status
+
+		return status.ObservedGeneration >= daemonset.Generation &&
+			status.UpdatedNumberScheduled == status.DesiredNumberScheduled &&
+			status.NumberAvailable == status.DesiredNumberScheduled, nil
+	default:
+		return false, fmt.Errorf("%w: %s", ErrRolloutUnsupported, kind)
+	}
+}
+
+// the API defaults unset replicas to 1
+func replicasOrDefault(replicas *int32) int32 {
+	if replicas == nil {
+		return 1
+	}
+
+	return *replicas
+}
@@ -0,0 +1,64 @@
+package k8s
+
+import (
+	"fmt"
+	"strings"
+
+	coreV1 "k8s.io/api/core/v1"
+)
+
+// Workload is the pod template of a deployment, statefulset or daemonset, along with its replica counts.
+type Workload struct {
+	Replicas      int32
+	ReadyReplicas int32
+
+	Annotations map[string]string
+
+	Template coreV1.PodTemplateSpec
+}
+
+func (k *KubernetesClient) GetWorkload(namespace, kind, name string) (*Workload, error) {
+	switch strings.ToLower(kind) {
+	case DeploymentKind:
+		deployment, err := k.GetDeployment(namespace, name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &Workload{
+			Replicas:      replicasOrDefault(deployment.Spec.Replicas),
+			ReadyReplicas: deployment.Status.ReadyReplicas,
+
+			Annotations: deployment.Annotations,
+			Template:    deployment.Spec.Template,
+		}, nil
+	case StatefulSetKind:
+		statefulset, err := k.GetStatefulSet(namespace, name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &Workload{
+			Replicas:      replicasOrDefault(statefulset.Spec.Replicas),
+			ReadyReplicas: statefulset.Status.ReadyReplicas,
+
+			Annotations: statefulset.Annotations,
+			Template:    statefulset.Spec.Template,
+		}, nil
+	case DaemonSetKind:
+		daemonset, err := k.GetDaemonSet(namespace, name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &Workload{
+			Replicas:      daemonset.Status.DesiredNumberScheduled,
+			ReadyReplicas: daemonset.Status.NumberReady,
+
+			Annotations: daemonset.Annotations,
+			Template:    daemonset.Spec.Template,
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported '%s' resource kind", kind)
+	}
+}
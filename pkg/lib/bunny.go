@@ -2,6 +2,7 @@ package lib
 
 import (
 	"context"
+	"os"
 
 	"bunnyshell.com/cli/pkg/build"
 	"bunnyshell.com/cli/pkg/config"
@@ -61,5 +62,12 @@ func getDefaultConfiguration() *sdk.Configuration {
 	configuration.Debug = config.GetSettings().Debug
 	configuration.HTTPClient = net.GetCLIClient()
 
+	if config.GetSettings().PrintRequest {
+		configuration.HTTPClient.Transport = net.RequestPrinterTransport{
+			Writer:  MaskWriter(os.Stderr),
+			Proxied: configuration.HTTPClient.Transport,
+		}
+	}
+
 	return configuration
 }
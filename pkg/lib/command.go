@@ -2,12 +2,17 @@ package lib
 
 import (
 	"errors"
+	"fmt"
+	"time"
 
 	"bunnyshell.com/cli/pkg/config"
 	"github.com/spf13/cobra"
 )
 
-var ErrNotStylish = errors.New("only stylish format is supported")
+var (
+	ErrNotStylish      = errors.New("only stylish format is supported")
+	ErrInvalidInterval = errors.New("the interval must be greater than 0")
+)
 
 func OnlyStylish(cmd *cobra.Command, args []string) error {
 	if config.GetSettings().IsStylish() {
@@ -16,3 +21,12 @@ func OnlyStylish(cmd *cobra.Command, args []string) error {
 
 	return ErrNotStylish
 }
+
+// ValidateInterval rejects the polling intervals which would never wait between checks.
+func ValidateInterval(interval time.Duration) error {
+	if interval > 0 {
+		return nil
+	}
+
+	return fmt.Errorf("%w, got %s", ErrInvalidInterval, interval)
+}
@@ -0,0 +1,33 @@
+package lib
+
+import "errors"
+
+type ExitCodeError struct {
+	Code int
+
+	Err error
+}
+
+func NewExitCodeError(code int, err error) *ExitCodeError {
+	return &ExitCodeError{
+		Code: code,
+		Err:  err,
+	}
+}
+
+func (e *ExitCodeError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *ExitCodeError) Unwrap() error {
+	return e.Err
+}
+
+func GetExitCode(err error) int {
+	var exitCodeError *ExitCodeError
+	if errors.As(err, &exitCodeError) {
+		return exitCodeError.Code
+	}
+
+	return 1
+}
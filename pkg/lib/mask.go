@@ -0,0 +1,119 @@
+package lib
+
+import (
+	"bytes"
+	"io"
+	"regexp"
+	"sync"
+
+	"bunnyshell.com/cli/pkg/config"
+)
+
+const maskReplacement = "****"
+
+// maskWriters are flushed on exit, holding the last line when it does not end with a newline
+var (
+	maskWriters      []*maskWriter
+	maskWritersMutex sync.Mutex
+)
+
+type maskWriter struct {
+	mutex sync.Mutex
+
+	writer io.Writer
+
+	patterns []*regexp.Regexp
+
+	// pending holds the data written since the last newline
+	pending []byte
+}
+
+// MaskWriter redacts the --mask-pattern matches from everything written to writer.
+// Matching is done line by line, so a split write cannot leak part of a match.
+func MaskWriter(writer io.Writer) io.Writer {
+	patterns := getMaskPatterns()
+
+	if len(patterns) == 0 {
+		return writer
+	}
+
+	mw := &maskWriter{
+		writer:   writer,
+		patterns: patterns,
+	}
+
+	maskWritersMutex.Lock()
+	defer maskWritersMutex.Unlock()
+
+	maskWriters = append(maskWriters, mw)
+
+	return mw
+}
+
+// MaskData redacts the --mask-pattern matches from data, for output not going through a MaskWriter.
+func MaskData(data []byte) []byte {
+	return maskBytes(getMaskPatterns(), data)
+}
+
+// FlushMaskWriters writes out the pending data of the mask writers.
+func FlushMaskWriters() {
+	maskWritersMutex.Lock()
+	defer maskWritersMutex.Unlock()
+
+	for _, mw := range maskWriters {
+		_ = mw.Flush()
+	}
+}
+
+func (mw *maskWriter) Write(data []byte) (int, error) {
+	mw.mutex.Lock()
+	defer mw.mutex.Unlock()
+
+	mw.pending = append(mw.pending, data...)
+
+	end := bytes.LastIndexByte(mw.pending, '\n')
+	if end < 0 {
+		return len(data), nil
+	}
+
+	if _, err := mw.writer.Write(maskBytes(mw.patterns, mw.pending[:end+1])); err != nil {
+		return 0, err
+	}
+
+	mw.pending = append(mw.pending[:0], mw.pending[end+1:]...)
+
+	return len(data), nil
+}
+
+func (mw *maskWriter) Flush() error {
+	mw.mutex.Lock()
+	defer mw.mutex.Unlock()
+
+	if len(mw.pending) == 0 {
+		return nil
+	}
+
+	_, err := mw.writer.Write(maskBytes(mw.patterns, mw.pending))
+	mw.pending = mw.pending[:0]
+
+	return err
+}
+
+func getMaskPatterns() []*regexp.Regexp {
+	patterns := []*regexp.Regexp{}
+
+	for _, pattern := range config.GetSettings().MaskPatterns {
+		// patterns are validated when the flag is set
+		patterns = append(patterns, regexp.MustCompile(pattern))
+	}
+
+	return patterns
+}
+
+func maskBytes(patterns []*regexp.Regexp, data []byte) []byte {
+	for _, pattern := range patterns {
+		data = pattern.ReplaceAll(data, []byte(maskReplacement))
+	}
+
+	return data
+}
@@ -0,0 +1,90 @@
+package lib
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"bunnyshell.com/cli/pkg/config/option"
+)
+
+var (
+	ErrNameNotFound  = errors.New("not found")
+	ErrAmbiguousName = errors.New("is ambiguous")
+)
+
+type NamedResource interface {
+	GetId() string
+	GetName() string
+}
+
+type NameLookup func(name string) ([]NamedResource, error)
+
+type NameResolver struct {
+	Kind string
+	Name string
+
+	// Option receives the resolved id
+	Option *option.String
+
+	Lookup NameLookup
+}
+
+// ResolveNames looks the names up in order, so each lookup is scoped by the ids resolved before it.
+func ResolveNames(resolvers []NameResolver) error {
+	for _, resolver := range resolvers {
+		if resolver.Name == "" {
+			continue
+		}
+
+		candidates, err := resolver.Lookup(resolver.Name)
+		if err != nil {
+			return fmt.Errorf("resolving %s %q: %w", resolver.Kind, resolver.Name, err)
+		}
+
+		id, err := MatchName(resolver.Kind, resolver.Name, candidates)
+		if err != nil {
+			return err
+		}
+
+		if err = resolver.Option.SetValue(id); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MatchName returns the id of the only candidate with the exact name, searches being fuzzy.
+func MatchName(kind string, name string, candidates []NamedResource) (string, error) {
+	ids := []string{}
+
+	for _, candidate := range candidates {
+		if candidate.GetName() == name {
+			ids = append(ids, candidate.GetId())
+		}
+	}
+
+	switch len(ids) {
+	case 0:
+		return "", fmt.Errorf("%s %q %w", kind, name, ErrNameNotFound)
+	case 1:
+		return ids[0], nil
+	default:
+		return "", fmt.Errorf("%s %q %w, use one of the ids instead: %s", kind, name, ErrAmbiguousName, strings.Join(ids, ", "))
+	}
+}
+
+// ToNamedResources adapts SDK collection items, their getters being defined on pointers.
+func ToNamedResources[T any, P interface {
+	*T
+	NamedResource
+}](items []T) []NamedResource {
+	resources := make([]NamedResource, 0, len(items))
+
+	for index := range items {
+		resources = append(resources, P(&items[index]))
+	}
+
+	return resources
+}
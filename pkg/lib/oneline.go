@@ -0,0 +1,22 @@
+package lib
+
+import (
+	"bunnyshell.com/cli/pkg/config"
+	"github.com/spf13/pflag"
+)
+
+// OnelineOptions provides --oneline as a shorthand for "--output oneline" on listings.
+type OnelineOptions struct {
+	Enabled bool
+}
+
+func (oo *OnelineOptions) UpdateFlagSet(flags *pflag.FlagSet) {
+	flags.BoolVar(&oo.Enabled, "oneline", oo.Enabled, "Print one line per resource: ID NAME STATUS UPDATED")
+}
+
+// Apply needs to run after the config was loaded, as it overrides the output format.
+func (oo *OnelineOptions) Apply() {
+	if oo.Enabled {
+		config.GetSettings().OutputFormat = config.OnelineFormat
+	}
+}
@@ -0,0 +1,39 @@
+package lib
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+)
+
+var errOutputThrough = errors.New("output command failed")
+
+// pipeOutput runs command through the shell with data as stdin and returns its stdout.
+func pipeOutput(command string, data []byte) ([]byte, error) {
+	shell, flag := "sh", "-c"
+	if runtime.GOOS == "windows" {
+		shell, flag = "cmd", "/C"
+	}
+
+	process := exec.Command(shell, flag, command)
+	process.Stdin = bytes.NewReader(data)
+	process.Stderr = os.Stderr
+
+	output, err := process.Output()
+	if err == nil {
+		return output, nil
+	}
+
+	var exitError *exec.ExitError
+	if errors.As(err, &exitError) {
+		return nil, NewExitCodeError(
+			exitError.ExitCode(),
+			fmt.Errorf("%w: %s exited with code %d", errOutputThrough, command, exitError.ExitCode()),
+		)
+	}
+
+	return nil, fmt.Errorf("%w: %w", errOutputThrough, err)
+}
@@ -67,6 +67,11 @@ func interactivePagination(cmd *cobra.Command, model ModelWithPagination) (int32
 		return 0, errQuit
 	}
 
+	// each page would replace the previous one in the file
+	if config.GetSettings().OutputFile != "" {
+		return 0, errQuit
+	}
+
 	navPage, err := ProcessPagination(cmd, model)
 	if err != nil {
 		return 0, err
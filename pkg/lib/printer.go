@@ -8,20 +8,55 @@ import (
 
 	"bunnyshell.com/cli/pkg/config"
 	"bunnyshell.com/cli/pkg/formatter"
+	"bunnyshell.com/cli/pkg/util"
 	"bunnyshell.com/sdk"
 	"github.com/spf13/cobra"
 )
 
 var ErrGeneric = errors.New("oops! Something went wrong")
 
+const outputFilePerm = 0o644
+
 func FormatCommandError(cmd *cobra.Command, err error) error {
-	_ = FormatCommandData(cmd, err)
+	// errors are always shown on the console, even when writing data to a file
+	_ = printCommandData(cmd, err)
 
 	return ErrGeneric
 }
 
 func FormatCommandData(cmd *cobra.Command, data interface{}) error {
-	result, err := formatter.Formatter(data, config.GetSettings().OutputFormat)
+	settings := config.GetSettings()
+
+	if settings.OutputFile == "" && settings.OutputThrough == "" {
+		return printCommandData(cmd, data)
+	}
+
+	result, err := formatData(data)
+	if err != nil {
+		cmd.PrintErrln(err)
+
+		return err
+	}
+
+	result = append(result, '\n')
+
+	if settings.OutputThrough != "" {
+		if result, err = pipeOutput(settings.OutputThrough, result); err != nil {
+			return err
+		}
+	}
+
+	if settings.OutputFile == "" {
+		cmd.Print(string(result))
+
+		return nil
+	}
+
+	return util.WriteFileAtomic(settings.OutputFile, MaskData(result), outputFilePerm)
+}
+
+func printCommandData(cmd *cobra.Command, data interface{}) error {
+	result, err := formatData(data)
 	if err != nil {
 		cmd.PrintErrln(err)
 
@@ -33,6 +68,16 @@ func FormatCommandData(cmd *cobra.Command, data interface{}) error {
 	return nil
 }
 
+func formatData(data interface{}) ([]byte, error) {
+	settings := config.GetSettings()
+
+	if settings.GroupBy != "" && settings.IsStylish() {
+		return formatter.GroupedStylish(data, settings.GroupBy)
+	}
+
+	return formatter.Formatter(data, settings.OutputFormat)
+}
+
 func FormatRequestResult(cmd *cobra.Command, data interface{}, resp *http.Response, err error) error {
 	if err != nil {
 		switch err := err.(type) {
@@ -59,20 +104,20 @@ func printGenericError(cmd *cobra.Command, err error, resp *http.Response) error
 		result["status"] = resp.StatusCode
 	}
 
-	return FormatCommandData(cmd, result)
+	return printCommandData(cmd, result)
 }
 
 func printOpenAPIError(cmd *cobra.Command, err *sdk.GenericOpenAPIError, resp *http.Response) error {
 	switch model := err.Model().(type) {
 	case sdk.ProblemGeneric:
-		return FormatCommandData(cmd, &model)
+		return printCommandData(cmd, &model)
 	}
 
 	data := sdk.NewProblemGeneric()
 	data.SetTitle(fmt.Sprintf("Response status: %d", resp.StatusCode))
 	data.SetDetail(err.Error())
 
-	return FormatCommandData(cmd, data)
+	return printCommandData(cmd, data)
 }
 
 func printTimeout(cmd *cobra.Command, err error) error {
@@ -80,5 +125,5 @@ func printTimeout(cmd *cobra.Command, err error) error {
 	data.SetTitle("Operation timed out")
 	data.SetDetail(err.Error())
 
-	return FormatCommandData(cmd, data)
+	return printCommandData(cmd, data)
 }
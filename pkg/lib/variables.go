@@ -0,0 +1,34 @@
+package lib
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	ErrInvalidVariablePair        = errors.New("invalid variable, expected NAME=value")
+	ErrDeleteConfirmationRequired = errors.New("deletion requires confirmation, use --yes in non-interactive mode")
+)
+
+// VariablePairs validates the arguments given as NAME=value.
+func VariablePairs(cmd *cobra.Command, args []string) error {
+	for _, pair := range args {
+		if name, _, found := strings.Cut(pair, "="); !found || name == "" {
+			return fmt.Errorf("%w: %s", ErrInvalidVariablePair, pair)
+		}
+	}
+
+	return nil
+}
+
+// GetVariableLabel names the variable next to its id, when the name is known.
+func GetVariableLabel(id string, name string) string {
+	if name == "" {
+		return id
+	}
+
+	return fmt.Sprintf("%s (%s)", name, id)
+}
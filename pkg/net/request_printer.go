@@ -0,0 +1,54 @@
+package net
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// RequestPrinterTransport writes the URL and body of mutating requests before proxying them.
+type RequestPrinterTransport struct {
+	Writer io.Writer
+
+	Proxied http.RoundTripper
+}
+
+func (rpt RequestPrinterTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if isMutatingMethod(req.Method) {
+		if err := rpt.print(req); err != nil {
+			return nil, err
+		}
+	}
+
+	return rpt.Proxied.RoundTrip(req)
+}
+
+func (rpt RequestPrinterTransport) print(req *http.Request) error {
+	fmt.Fprintf(rpt.Writer, "%s %s\n", req.Method, req.URL.String())
+
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil
+	}
+
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return err
+	}
+
+	_ = req.Body.Close()
+	req.Body = io.NopCloser(bytes.NewReader(body))
+
+	fmt.Fprintf(rpt.Writer, "%s\n", body)
+
+	return nil
+}
+
+func isMutatingMethod(method string) bool {
+	switch method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,112 @@
+package organization
+
+import (
+	"fmt"
+	"sort"
+	"text/tabwriter"
+
+	"bunnyshell.com/cli/pkg/api/environment"
+	"bunnyshell.com/cli/pkg/api/organization"
+	"bunnyshell.com/cli/pkg/api/pipeline"
+	"bunnyshell.com/cli/pkg/progress"
+)
+
+type OrganizationUsage struct {
+	Organization string `json:"organization" yaml:"organization"`
+	Name         string `json:"name" yaml:"name"`
+
+	Projects     int `json:"projects" yaml:"projects"`
+	Environments int `json:"environments" yaml:"environments"`
+
+	EnvironmentsByType   map[string]int `json:"environmentsByType" yaml:"environmentsByType"`
+	EnvironmentsByStatus map[string]int `json:"environmentsByStatus" yaml:"environmentsByStatus"`
+
+	// pipelines are what builds and deploys run in, the ones in progress being the current concurrency
+	InProgressPipelines int `json:"inProgressPipelines" yaml:"inProgressPipelines"`
+	PendingPipelines    int `json:"pendingPipelines" yaml:"pendingPipelines"`
+}
+
+func (usage *OrganizationUsage) Tabulate(w *tabwriter.Writer) {
+	fmt.Fprintf(w, "%v\t %v\n", "OrganizationID", usage.Organization)
+	fmt.Fprintf(w, "%v\t %v\n", "Name", usage.Name)
+	fmt.Fprintf(w, "%v\t %v\n", "Projects", usage.Projects)
+	fmt.Fprintf(w, "%v\t %v\n", "Environments", usage.Environments)
+
+	tabulateCounts(w, "ByType", usage.EnvironmentsByType)
+	tabulateCounts(w, "ByStatus", usage.EnvironmentsByStatus)
+
+	fmt.Fprintf(w, "%v\t %v\n", "InProgressPipelines", usage.InProgressPipelines)
+	fmt.Fprintf(w, "%v\t %v\n", "PendingPipelines", usage.PendingPipelines)
+}
+
+func tabulateCounts(w *tabwriter.Writer, label string, counts map[string]int) {
+	keys := make([]string, 0, len(counts))
+	for key := range counts {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	for index, key := range keys {
+		if index == 0 {
+			fmt.Fprintf(w, "%v\t %v\t %v\n", label, key, counts[key])
+		} else {
+			fmt.Fprintf(w, "\t %v\t %v\n", key, counts[key])
+		}
+	}
+}
+
+// GetOrganizationUsage counts the environments of the organization and its running pipelines.
+func GetOrganizationUsage(organizationID string) (*OrganizationUsage, error) {
+	model, err := organization.Get(organization.NewItemOptions(organizationID))
+	if err != nil {
+		return nil, err
+	}
+
+	listOptions := environment.NewListOptions()
+	listOptions.Organization = organizationID
+
+	environments, err := environment.ListAll(*listOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	usage := &OrganizationUsage{
+		Organization: model.GetId(),
+		Name:         model.GetName(),
+
+		Projects:     int(model.GetTotalProjects()),
+		Environments: len(environments),
+
+		EnvironmentsByType:   map[string]int{},
+		EnvironmentsByStatus: map[string]int{},
+	}
+
+	for _, item := range environments {
+		usage.EnvironmentsByType[item.GetType()]++
+		usage.EnvironmentsByStatus[item.GetOperationStatus()]++
+	}
+
+	if usage.InProgressPipelines, err = countPipelines(organizationID, progress.StatusInProgress); err != nil {
+		return nil, err
+	}
+
+	if usage.PendingPipelines, err = countPipelines(organizationID, progress.StatusPending); err != nil {
+		return nil, err
+	}
+
+	return usage, nil
+}
+
+func countPipelines(organizationID string, status string) (int, error) {
+	listOptions := pipeline.NewListOptions()
+	listOptions.Organization = organizationID
+	listOptions.Status = status
+
+	model, err := pipeline.List(listOptions)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(model.GetTotalItems()), nil
+}
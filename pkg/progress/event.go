@@ -1,6 +1,7 @@
 package progress
 
 import (
+	"io"
 	"time"
 
 	"bunnyshell.com/cli/pkg/api/pipeline"
@@ -9,10 +10,15 @@ import (
 )
 
 func EventToPipeline(event *sdk.EventItem, options *Options) (*sdk.PipelineItem, error) {
+	options.startTimeout()
+
 	resume := net.PauseSpinner()
 	defer resume()
 
 	spinner := net.MakeSpinner()
+	if options.Silent {
+		spinner.Writer = io.Discard
+	}
 
 	spinner.Start()
 	defer spinner.Stop()
@@ -36,6 +42,10 @@ func handlePipeline(event *sdk.EventItem, options *Options) (*sdk.PipelineItem,
 		}
 
 		if !collection.HasEmbedded() {
+			if err = options.checkTimeout(); err != nil {
+				return nil, err
+			}
+
 			time.Sleep(options.Interval)
 
 			continue
@@ -11,6 +11,8 @@ func Pipeline(pipelineID string, options *Options) error {
 		options = NewOptions()
 	}
 
+	options.startTimeout()
+
 	resume := net.PauseSpinner()
 	defer resume()
 
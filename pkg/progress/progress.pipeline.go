@@ -2,6 +2,7 @@ package progress
 
 import (
 	"fmt"
+	"io"
 	"time"
 
 	"bunnyshell.com/sdk"
@@ -20,6 +21,14 @@ type Progress struct {
 
 type Options struct {
 	Interval time.Duration
+
+	// Timeout stops waiting once exceeded, 0 waits indefinitely
+	Timeout time.Duration
+
+	// Silent keeps polling without writing any progress output
+	Silent bool
+
+	deadline time.Time
 }
 
 func NewOptions() *Options {
@@ -28,6 +37,23 @@ func NewOptions() *Options {
 	}
 }
 
+// startTimeout is a no-op once started, so the event and the pipeline waits share the same deadline.
+func (o *Options) startTimeout() {
+	if o.Timeout <= 0 || !o.deadline.IsZero() {
+		return
+	}
+
+	o.deadline = time.Now().Add(o.Timeout)
+}
+
+func (o *Options) checkTimeout() error {
+	if o.deadline.IsZero() || time.Now().Before(o.deadline) {
+		return nil
+	}
+
+	return fmt.Errorf("%w after %s", ErrTimeout, o.Timeout)
+}
+
 func NewPipeline(options Options) *Progress {
 	spinner := spinner.New(spinner.CharSets[defaultProgressSet], defaultSpinnerUpdate)
 	spinner.Prefix = fmt.Sprintf(
@@ -35,6 +61,10 @@ func NewPipeline(options Options) *Progress {
 		statusMap[PipelineWorking],
 	)
 
+	if options.Silent {
+		spinner.Writer = io.Discard
+	}
+
 	return &Progress{
 		Options: options,
 
@@ -59,6 +89,10 @@ func (p *Progress) Update(pipelineSync PipelineSyncer) error {
 			return nil
 		}
 
+		if err = p.Options.checkTimeout(); err != nil {
+			return err
+		}
+
 		time.Sleep(p.Options.Interval)
 	}
 }
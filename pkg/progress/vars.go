@@ -46,4 +46,7 @@ var statusMap = map[PipelineStatus]string{
 	PipelineUnknownState: color.New(color.FgYellow).Sprintf("?"),
 }
 
-var ErrPipeline = errors.New("pipeline has encountered an error")
+var (
+	ErrPipeline = errors.New("pipeline has encountered an error")
+	ErrTimeout  = errors.New("timed out waiting for the pipeline")
+)
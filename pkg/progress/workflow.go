@@ -50,6 +50,10 @@ func delegateEvent(eventID string, options *Options) (*sdk.EventItem, error) {
 			return event.Get(event.NewItemOptions(delegatedID))
 
 		default:
+			if err = options.checkTimeout(); err != nil {
+				return nil, err
+			}
+
 			time.Sleep(options.Interval)
 		}
 	}
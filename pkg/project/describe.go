@@ -0,0 +1,127 @@
+package project
+
+import (
+	"fmt"
+	"text/tabwriter"
+	"time"
+
+	"bunnyshell.com/cli/pkg/api/environment"
+	"bunnyshell.com/cli/pkg/api/event"
+	"bunnyshell.com/cli/pkg/api/project"
+	"bunnyshell.com/cli/pkg/formatter"
+	bunnysdk "bunnyshell.com/sdk"
+)
+
+const deployEventType = "env_deploy"
+
+type ProjectEnvironment struct {
+	ID   string `json:"id" yaml:"id"`
+	Name string `json:"name" yaml:"name"`
+	Type string `json:"type" yaml:"type"`
+
+	OperationStatus  string     `json:"operationStatus" yaml:"operationStatus"`
+	LastDeployStatus string     `json:"lastDeployStatus,omitempty" yaml:"lastDeployStatus,omitempty"`
+	LastDeployAt     *time.Time `json:"lastDeployAt,omitempty" yaml:"lastDeployAt,omitempty"`
+}
+
+type ProjectDescription struct {
+	Project *bunnysdk.ProjectItem `json:"project" yaml:"project"`
+
+	Environments []ProjectEnvironment `json:"environments" yaml:"environments"`
+}
+
+// Tabulate renders the project, followed by the summary of its environments.
+func (description *ProjectDescription) Tabulate(w *tabwriter.Writer) {
+	formatter.WriteStylish(w, description.Project)
+
+	// flush the project table so the environments align on their own
+	w.Flush()
+
+	fmt.Fprintln(w)
+
+	if len(description.Environments) == 0 {
+		fmt.Fprintln(w, "No environments in the project.")
+
+		return
+	}
+
+	fmt.Fprintf(w, "%v\t %v\t %v\t %v\t %v\n", "EnvironmentID", "Name", "Type", "OperationStatus", "LastDeploy")
+
+	for _, item := range description.Environments {
+		fmt.Fprintf(w, "%v\t %v\t %v\t %v\t %v\n", item.ID, item.Name, item.Type, item.OperationStatus, item.lastDeploy())
+	}
+}
+
+func (item ProjectEnvironment) lastDeploy() string {
+	if item.LastDeployAt == nil {
+		return "never"
+	}
+
+	return fmt.Sprintf("%s (%s)", item.LastDeployAt.Format(time.RFC3339), item.LastDeployStatus)
+}
+
+// DescribeProject fetches the project along with a summary of its environments and their last deploy.
+func DescribeProject(projectID string) (*ProjectDescription, error) {
+	model, err := project.Get(project.NewItemOptions(projectID))
+	if err != nil {
+		return nil, err
+	}
+
+	listOptions := environment.NewListOptions()
+	listOptions.Project = projectID
+
+	environments, err := environment.ListAll(*listOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	description := &ProjectDescription{
+		Project: model,
+
+		Environments: []ProjectEnvironment{},
+	}
+
+	for _, environmentItem := range environments {
+		item := ProjectEnvironment{
+			ID:   environmentItem.GetId(),
+			Name: environmentItem.GetName(),
+			Type: environmentItem.GetType(),
+
+			OperationStatus: environmentItem.GetOperationStatus(),
+		}
+
+		lastDeploy, err := getLastDeploy(item.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		if lastDeploy != nil {
+			createdAt := lastDeploy.GetCreatedAt()
+
+			item.LastDeployStatus = lastDeploy.GetStatus()
+			item.LastDeployAt = &createdAt
+		}
+
+		description.Environments = append(description.Environments, item)
+	}
+
+	return description, nil
+}
+
+// getLastDeploy returns the latest deploy event of the environment, nil when it was never deployed.
+func getLastDeploy(environmentID string) (*bunnysdk.EventCollection, error) {
+	listOptions := event.NewListOptions()
+	listOptions.Environment = environmentID
+	listOptions.Type = deployEventType
+
+	model, err := event.List(listOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	if !model.HasEmbedded() || len(model.Embedded.Item) == 0 {
+		return nil, nil
+	}
+
+	return &model.Embedded.Item[0], nil
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 
+	"bunnyshell.com/cli/pkg/k8s"
 	"bunnyshell.com/cli/pkg/remote_development/workspace"
 	"bunnyshell.com/dev/pkg/remote"
 	"bunnyshell.com/sdk"
@@ -44,3 +45,17 @@ func (action *Action) GetRemoteDev(resource sdk.ComponentResourceItem) (*remote.
 		return nil, fmt.Errorf("%w: %s", ErrResourceKindNotSupported, kind)
 	}
 }
+
+func (action *Action) HasRemoteDevSession(resource sdk.ComponentResourceItem) (bool, error) {
+	kubeConfigFile, err := action.workspace.GetKubeConfigFile()
+	if err != nil {
+		return false, err
+	}
+
+	client, err := k8s.NewKubernetesClient(kubeConfigFile)
+	if err != nil {
+		return false, err
+	}
+
+	return client.HasRemoteDevSession(resource.GetNamespace(), resource.GetKind(), resource.GetName())
+}
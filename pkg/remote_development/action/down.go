@@ -26,5 +26,16 @@ func (down *Down) Run(parameters *DownParameters) error {
 		return err
 	}
 
-	return remoteDev.Down()
+	err = remoteDev.Down()
+	if err == nil {
+		return nil
+	}
+
+	// Only report a missing session when the resource is known not to be in remote development
+	active, checkErr := down.Action.HasRemoteDevSession(parameters.Resource)
+	if checkErr != nil || active {
+		return err
+	}
+
+	return ErrNoActiveSession
 }
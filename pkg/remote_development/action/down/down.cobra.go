@@ -10,6 +10,7 @@ func (down *Options) UpdateFlagSet(
 	flags *pflag.FlagSet,
 ) {
 	flags.StringVarP(&down.resourcePath, "resource", "s", down.resourcePath, "The cluster resource to use (namespace/kind/name format).")
+	flags.BoolVar(&down.Strict, "strict", down.Strict, "Exit with a distinct code when there is no active remote development session")
 
 	down.manager.UpdateFlagSet(command, flags)
 }
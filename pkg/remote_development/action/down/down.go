@@ -9,6 +9,8 @@ import (
 type Options struct {
 	ManualSelectSingleResource bool
 
+	Strict bool
+
 	manager *config.Manager
 
 	resourceLoader *bridge.ResourceLoader
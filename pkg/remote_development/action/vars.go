@@ -6,4 +6,6 @@ var (
 	ErrOneSyncPathSupported = errors.New("only one sync path is supported")
 
 	ErrRemoteDevNotInitialized = errors.New("call Up.Run() successfully before calling other methods")
+
+	ErrNoActiveSession = errors.New("no active remote development session")
 )
@@ -0,0 +1,26 @@
+package util
+
+import (
+	"os/exec"
+	"runtime"
+)
+
+// OpenURL launches the default browser without waiting for it to exit.
+func OpenURL(url string) error {
+	var command *exec.Cmd
+
+	switch runtime.GOOS {
+	case "darwin":
+		command = exec.Command("open", url)
+	case "windows":
+		command = exec.Command("rundll32", "url.dll,FileProtocolHandler", url)
+	default:
+		command = exec.Command("xdg-open", url)
+	}
+
+	if err := command.Start(); err != nil {
+		return err
+	}
+
+	return command.Process.Release()
+}
@@ -0,0 +1,147 @@
+package util
+
+import (
+	"fmt"
+	"strings"
+)
+
+const diffContextLines = 3
+
+type diffOperation struct {
+	kind byte
+	line string
+
+	fromLine int
+	toLine   int
+}
+
+// UnifiedDiff compares the lines of two texts, returning an empty string when they are equal.
+func UnifiedDiff(fromName string, toName string, from string, to string) string {
+	operations := diffLines(splitLines(from), splitLines(to))
+
+	hunks := groupHunks(operations)
+	if len(hunks) == 0 {
+		return ""
+	}
+
+	var builder strings.Builder
+
+	fmt.Fprintf(&builder, "--- %s\n+++ %s\n", fromName, toName)
+
+	for _, hunk := range hunks {
+		writeHunk(&builder, hunk)
+	}
+
+	return builder.String()
+}
+
+func splitLines(text string) []string {
+	text = strings.TrimRight(text, "\n")
+	if text == "" {
+		return []string{}
+	}
+
+	return strings.Split(text, "\n")
+}
+
+// diffLines builds the edit script from the longest common subsequence of both sides.
+func diffLines(from []string, to []string) []diffOperation {
+	lcs := make([][]int, len(from)+1)
+	for index := range lcs {
+		lcs[index] = make([]int, len(to)+1)
+	}
+
+	for i := len(from) - 1; i >= 0; i-- {
+		for j := len(to) - 1; j >= 0; j-- {
+			if from[i] == to[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	operations := []diffOperation{}
+
+	i, j := 0, 0
+	for i < len(from) || j < len(to) {
+		switch {
+		case i < len(from) && j < len(to) && from[i] == to[j]:
+			operations = append(operations, diffOperation{' ', from[i], i, j})
+			i++
+			j++
+		case i < len(from) && (j == len(to) || lcs[i+1][j] >= lcs[i][j+1]):
+			operations = append(operations, diffOperation{'-', from[i], i, j})
+			i++
+		default:
+			operations = append(operations, diffOperation{'+', to[j], i, j})
+			j++
+		}
+	}
+
+	return operations
+}
+
+func groupHunks(operations []diffOperation) [][]diffOperation {
+	hunks := [][]diffOperation{}
+
+	start, end := -1, -1
+
+	for index, operation := range operations {
+		if operation.kind == ' ' {
+			continue
+		}
+
+		hunkStart := max(index-diffContextLines, 0)
+
+		if start != -1 && hunkStart > end {
+			hunks = append(hunks, operations[start:end])
+			start = -1
+		}
+
+		if start == -1 {
+			start = hunkStart
+		}
+
+		end = min(index+diffContextLines+1, len(operations))
+	}
+
+	if start != -1 {
+		hunks = append(hunks, operations[start:end])
+	}
+
+	return hunks
+}
+
+func writeHunk(builder *strings.Builder, hunk []diffOperation) {
+	fromCount, toCount := 0, 0
+
+	for _, operation := range hunk {
+		if operation.kind != '+' {
+			fromCount++
+		}
+
+		if operation.kind != '-' {
+			toCount++
+		}
+	}
+
+	fmt.Fprintf(
+		builder,
+		"@@ -%s +%s @@\n",
+		hunkRange(hunk[0].fromLine, fromCount),
+		hunkRange(hunk[0].toLine, toCount),
+	)
+
+	for _, operation := range hunk {
+		fmt.Fprintf(builder, "%c%s\n", operation.kind, operation.line)
+	}
+}
+
+func hunkRange(line int, count int) string {
+	if count == 0 {
+		return fmt.Sprintf("%d,0", line)
+	}
+
+	return fmt.Sprintf("%d,%d", line+1, count)
+}
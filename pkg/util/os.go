@@ -1,6 +1,9 @@
 package util
 
-import "os"
+import (
+	"os"
+	"path/filepath"
+)
 
 func FileExists(path string) (bool, error) {
 	_, err := os.Stat(path)
@@ -23,3 +26,36 @@ func IsStdinPresent() (bool, error) {
 
 	return (fi.Mode() & os.ModeCharDevice) == 0, nil
 }
+
+// WriteFileAtomic writes into a temporary file next to the target, then renames it over the target.
+func WriteFileAtomic(name string, data []byte, perm os.FileMode) error {
+	file, err := os.CreateTemp(filepath.Dir(name), "."+filepath.Base(name)+".*")
+	if err != nil {
+		return err
+	}
+
+	tempName := file.Name()
+	defer os.Remove(tempName)
+
+	if _, err = file.Write(data); err != nil {
+		file.Close()
+
+		return err
+	}
+
+	if err = file.Sync(); err != nil {
+		file.Close()
+
+		return err
+	}
+
+	if err = file.Close(); err != nil {
+		return err
+	}
+
+	if err = os.Chmod(tempName, perm); err != nil {
+		return err
+	}
+
+	return os.Rename(tempName, name)
+}
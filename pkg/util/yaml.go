@@ -0,0 +1,14 @@
+package util
+
+import "gopkg.in/yaml.v3"
+
+// FindKey returns the value of key in the mapping node, or nil when it is missing.
+func FindKey(node *yaml.Node, key string) *yaml.Node {
+	for index := 0; index+1 < len(node.Content); index += 2 {
+		if node.Content[index].Value == key {
+			return node.Content[index+1]
+		}
+	}
+
+	return nil
+}
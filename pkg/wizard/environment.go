@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"bunnyshell.com/cli/pkg/api/environment"
+	"bunnyshell.com/cli/pkg/config"
 	"bunnyshell.com/sdk"
 )
 
@@ -48,6 +49,10 @@ func (w *Wizard) selectEnvironment(page int32) (*sdk.EnvironmentCollection, erro
 
 	collectionItems := embedded.GetItem()
 
+	if config.GetSettings().ShouldAutoSelect(model.GetTotalItems()) && len(collectionItems) == 1 {
+		return &collectionItems[0], nil
+	}
+
 	items := []string{}
 	for _, item := range collectionItems {
 		items = append(items, fmt.Sprintf("%s (%s)", item.GetName(), item.GetId()))
@@ -0,0 +1,56 @@
+package k8s
+
+import (
+	"bunnyshell.com/cli/pkg/api/component"
+	"bunnyshell.com/cli/pkg/interactive"
+	"bunnyshell.com/sdk"
+)
+
+const (
+	Job     = "Job"
+	CronJob = "CronJob"
+)
+
+type ExecListOptions struct {
+	Component string
+}
+
+func ExecList(options *ExecListOptions) ([]sdk.ComponentResourceItem, error) {
+	resources, err := component.Resources(component.NewResourceOptions(options.Component))
+	if err != nil {
+		return nil, err
+	}
+
+	execResources := []sdk.ComponentResourceItem{}
+
+	for _, resource := range resources {
+		switch resource.GetKind() {
+		case Deployment, StatefulSet, DaemonSet, Job, CronJob:
+			execResources = append(execResources, resource)
+		}
+	}
+
+	return execResources, nil
+}
+
+func ExecSelect(options *ExecListOptions) (*sdk.ComponentResourceItem, error) {
+	resources, err := ExecList(options)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(resources) == 0 {
+		return nil, errEmptyList
+	}
+
+	if len(resources) == 1 {
+		return &resources[0], nil
+	}
+
+	index, _, err := interactive.Choose("Choose a resource", resourceToSelectorItems(resources))
+	if err != nil {
+		return nil, err
+	}
+
+	return &resources[index], nil
+}